@@ -0,0 +1,354 @@
+// Package arena provides a doubly linked list whose nodes live in
+// contiguous chunks rather than in separate heap allocations. Nodes are
+// addressed by index, removed nodes are kept on a free list for reuse, and
+// chunks of pointer-free values are never scanned by the garbage collector.
+package arena
+
+import (
+	"errors"
+	"iter"
+)
+
+// chunkSize is the number of nodes allocated at a time
+const chunkSize = 256
+
+// ErrInvalidHandle is returned when a handle does not refer to a node in
+// the list
+var ErrInvalidHandle = errors.New("arena: handle does not refer to a node in the list")
+
+// Handle refers to a node in a List. The zero Handle refers to no node.
+// A handle is invalidated when its node is removed, and may later be
+// reused for a new node.
+type Handle int32
+
+// List is a doubly linked list holding values of type T. The zero value is
+// an empty list.
+type List[T any] struct {
+	chunks [][]slot[T]
+	head   Handle
+	tail   Handle
+	free   Handle
+	size   int
+	// used counts the slots handed out so far, free or not
+	used int
+}
+
+type slot[T any] struct {
+	value    T
+	next     Handle
+	previous Handle
+	inUse    bool
+}
+
+// New returns an empty list
+func New[T any]() List[T] {
+	return List[T]{}
+}
+
+func (l *List[T]) slot(h Handle) *slot[T] {
+	i := int(h) - 1
+	return &l.chunks[i/chunkSize][i%chunkSize]
+}
+
+func (l *List[T]) valid(h Handle) bool {
+	return h > 0 && int(h) <= l.used && l.slot(h).inUse
+}
+
+func (l *List[T]) alloc(value T) Handle {
+	var h Handle
+	if l.free != 0 {
+		h = l.free
+		l.free = l.slot(h).next
+	} else {
+		if l.used == len(l.chunks)*chunkSize {
+			l.chunks = append(l.chunks, make([]slot[T], chunkSize))
+		}
+		l.used++
+		h = Handle(l.used)
+	}
+	*l.slot(h) = slot[T]{value: value, inUse: true}
+	return h
+}
+
+// link links the detached node h after mark; a zero mark means the front
+func (l *List[T]) link(h, mark Handle) {
+	s := l.slot(h)
+	var next Handle
+	if mark == 0 {
+		next = l.head
+		l.head = h
+	} else {
+		next = l.slot(mark).next
+		l.slot(mark).next = h
+	}
+	s.previous = mark
+	s.next = next
+	if next == 0 {
+		l.tail = h
+	} else {
+		l.slot(next).previous = h
+	}
+	l.size++
+}
+
+func (l *List[T]) unlink(h Handle) {
+	s := l.slot(h)
+	if s.previous == 0 {
+		l.head = s.next
+	} else {
+		l.slot(s.previous).next = s.next
+	}
+	if s.next == 0 {
+		l.tail = s.previous
+	} else {
+		l.slot(s.next).previous = s.previous
+	}
+	s.next, s.previous = 0, 0
+	l.size--
+}
+
+func (l *List[T]) release(h Handle) T {
+	s := l.slot(h)
+	value := s.value
+	*s = slot[T]{next: l.free}
+	l.free = h
+	return value
+}
+
+// Add adds a value to the end of the list and returns its handle
+func (l *List[T]) Add(value T) Handle {
+	h := l.alloc(value)
+	l.link(h, l.tail)
+	return h
+}
+
+// AddToStart adds a value to the start of the list and returns its handle
+func (l *List[T]) AddToStart(value T) Handle {
+	h := l.alloc(value)
+	l.link(h, 0)
+	return h
+}
+
+// InsertBefore adds a value before the node mark and returns its handle
+func (l *List[T]) InsertBefore(mark Handle, value T) (Handle, error) {
+	if !l.valid(mark) {
+		return 0, ErrInvalidHandle
+	}
+	h := l.alloc(value)
+	l.link(h, l.slot(mark).previous)
+	return h, nil
+}
+
+// InsertAfter adds a value after the node mark and returns its handle
+func (l *List[T]) InsertAfter(mark Handle, value T) (Handle, error) {
+	if !l.valid(mark) {
+		return 0, ErrInvalidHandle
+	}
+	h := l.alloc(value)
+	l.link(h, mark)
+	return h, nil
+}
+
+// Head returns the handle of the first node
+func (l *List[T]) Head() Handle {
+	return l.head
+}
+
+// Tail returns the handle of the last node
+func (l *List[T]) Tail() Handle {
+	return l.tail
+}
+
+// Next returns the handle of the node after h
+func (l *List[T]) Next(h Handle) Handle {
+	if !l.valid(h) {
+		return 0
+	}
+	return l.slot(h).next
+}
+
+// Previous returns the handle of the node before h
+func (l *List[T]) Previous(h Handle) Handle {
+	if !l.valid(h) {
+		return 0
+	}
+	return l.slot(h).previous
+}
+
+// Value returns the value of the node h
+func (l *List[T]) Value(h Handle) (T, bool) {
+	if !l.valid(h) {
+		var zero T
+		return zero, false
+	}
+	return l.slot(h).value, true
+}
+
+// SetValue replaces the value of the node h
+func (l *List[T]) SetValue(h Handle, value T) bool {
+	if !l.valid(h) {
+		return false
+	}
+	l.slot(h).value = value
+	return true
+}
+
+// Remove removes the node h and returns its value
+func (l *List[T]) Remove(h Handle) (T, error) {
+	if !l.valid(h) {
+		var zero T
+		return zero, ErrInvalidHandle
+	}
+	l.unlink(h)
+	return l.release(h), nil
+}
+
+// RemoveHead removes the first node and returns its value
+func (l *List[T]) RemoveHead() (T, bool) {
+	if l.head == 0 {
+		var zero T
+		return zero, false
+	}
+	v, _ := l.Remove(l.head)
+	return v, true
+}
+
+// RemoveTail removes the last node and returns its value
+func (l *List[T]) RemoveTail() (T, bool) {
+	if l.tail == 0 {
+		var zero T
+		return zero, false
+	}
+	v, _ := l.Remove(l.tail)
+	return v, true
+}
+
+// MoveToFront moves the node h to the start of the list
+func (l *List[T]) MoveToFront(h Handle) error {
+	if !l.valid(h) {
+		return ErrInvalidHandle
+	}
+	if h != l.head {
+		l.unlink(h)
+		l.link(h, 0)
+	}
+	return nil
+}
+
+// MoveToBack moves the node h to the end of the list
+func (l *List[T]) MoveToBack(h Handle) error {
+	if !l.valid(h) {
+		return ErrInvalidHandle
+	}
+	if h != l.tail {
+		l.unlink(h)
+		l.link(h, l.tail)
+	}
+	return nil
+}
+
+// Find returns the handle of the first node whose value matches
+func (l *List[T]) Find(matcher func(T) bool) (bool, Handle) {
+	for h := l.head; h != 0; h = l.slot(h).next {
+		if matcher(l.slot(h).value) {
+			return true, h
+		}
+	}
+	return false, 0
+}
+
+// IndexOf returns the index of the first node whose value matches, or -1
+func (l *List[T]) IndexOf(matcher func(T) bool) int {
+	i := 0
+	for h := l.head; h != 0; h = l.slot(h).next {
+		if matcher(l.slot(h).value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// Get returns the value at the specified index
+func (l *List[T]) Get(index int) (bool, T) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return false, zero
+	}
+	var h Handle
+	if index < l.size/2 {
+		h = l.head
+		for i := 0; i < index; i++ {
+			h = l.slot(h).next
+		}
+	} else {
+		h = l.tail
+		for i := l.size - 1; i > index; i-- {
+			h = l.slot(h).previous
+		}
+	}
+	return true, l.slot(h).value
+}
+
+// Size returns the total number of nodes in the list
+func (l *List[T]) Size() int {
+	return l.size
+}
+
+// Clear removes all nodes from the list, keeping its chunks for reuse
+func (l *List[T]) Clear() {
+	for i := range l.chunks {
+		clear(l.chunks[i])
+	}
+	l.head, l.tail, l.free = 0, 0, 0
+	l.size, l.used = 0, 0
+}
+
+// ToSlice returns a slice of the values in the list
+func (l *List[T]) ToSlice() []T {
+	values := make([]T, 0, l.size)
+	for h := l.head; h != 0; h = l.slot(h).next {
+		values = append(values, l.slot(h).value)
+	}
+	return values
+}
+
+// All returns a sequence of the handles and values of the nodes, from Head
+// to Tail
+func (l *List[T]) All() iter.Seq2[Handle, T] {
+	return func(yield func(Handle, T) bool) {
+		for h := l.head; h != 0; {
+			next := l.slot(h).next
+			if !yield(h, l.slot(h).value) {
+				return
+			}
+			h = next
+		}
+	}
+}
+
+// Values returns a sequence of the values in the list
+func (l *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range l.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence of the indexes and values of the nodes, from
+// Tail to Head
+func (l *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := l.size - 1
+		for h := l.tail; h != 0; i-- {
+			previous := l.slot(h).previous
+			if !yield(i, l.slot(h).value) {
+				return
+			}
+			h = previous
+		}
+	}
+}
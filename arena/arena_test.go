@@ -0,0 +1,259 @@
+package arena_test
+
+import (
+	"math/rand"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/arena"
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func TestAdd(t *testing.T) {
+	var list arena.List[int]
+	ok(t, list.Head() == 0 && list.Tail() == 0)
+	list.Add(2)
+	list.Add(3)
+	list.AddToStart(1)
+	assertListValues(t, list, 1, 2, 3)
+}
+
+func TestInsert(t *testing.T) {
+	list := arena.New[int]()
+	mark := list.Add(2)
+	_, err := list.InsertBefore(mark, 1)
+	ok(t, err == nil)
+	h, err := list.InsertAfter(mark, 4)
+	ok(t, err == nil)
+	_, err = list.InsertBefore(h, 3)
+	ok(t, err == nil)
+	assertListValues(t, list, 1, 2, 3, 4)
+
+	_, err = list.InsertAfter(0, 5)
+	ok(t, err == arena.ErrInvalidHandle)
+	_, err = list.InsertAfter(99, 5)
+	ok(t, err == arena.ErrInvalidHandle)
+}
+
+func TestNavigation(t *testing.T) {
+	list := newList(1, 2, 3)
+	h := list.Head()
+	v, found := list.Value(h)
+	ok(t, found && v == 1)
+	h = list.Next(h)
+	ok(t, list.SetValue(h, 20))
+	ok(t, list.Previous(h) == list.Head())
+	ok(t, list.Next(list.Next(h)) == 0)
+	ok(t, list.Previous(list.Head()) == 0)
+	assertListValues(t, list, 1, 20, 3)
+}
+
+func TestRemove(t *testing.T) {
+	list := arena.New[int]()
+	a := list.Add(1)
+	b := list.Add(2)
+	list.Add(3)
+
+	v, err := list.Remove(b)
+	ok(t, err == nil && v == 2)
+	_, err = list.Remove(b)
+	ok(t, err == arena.ErrInvalidHandle)
+	_, found := list.Value(b)
+	ok(t, !found)
+	ok(t, !list.SetValue(b, 9))
+	assertListValues(t, list, 1, 3)
+
+	ok(t, list.Add(4) == b)
+	assertListValues(t, list, 1, 3, 4)
+
+	v, found = list.RemoveHead()
+	ok(t, found && v == 1)
+	v, found = list.RemoveTail()
+	ok(t, found && v == 4)
+	_, err = list.Remove(a)
+	ok(t, err == arena.ErrInvalidHandle)
+	v, found = list.RemoveTail()
+	ok(t, found && v == 3)
+	assertListValues(t, list)
+	_, found = list.RemoveHead()
+	ok(t, !found)
+	_, found = list.RemoveTail()
+	ok(t, !found)
+}
+
+func TestMove(t *testing.T) {
+	list := arena.New[int]()
+	a := list.Add(1)
+	list.Add(2)
+	c := list.Add(3)
+	ok(t, list.MoveToFront(c) == nil)
+	assertListValues(t, list, 3, 1, 2)
+	ok(t, list.MoveToBack(a) == nil)
+	assertListValues(t, list, 3, 2, 1)
+	ok(t, list.MoveToFront(c) == nil)
+	ok(t, list.MoveToBack(a) == nil)
+	assertListValues(t, list, 3, 2, 1)
+	ok(t, list.MoveToFront(0) == arena.ErrInvalidHandle)
+	ok(t, list.MoveToBack(0) == arena.ErrInvalidHandle)
+}
+
+func TestFind(t *testing.T) {
+	list := newList(1, 2, 3, 2)
+	found, h := list.Find(func(v int) bool { return v == 2 })
+	ok(t, found && h == list.Next(list.Head()))
+	found, _ = list.Find(func(v int) bool { return v == 5 })
+	ok(t, !found)
+	ok(t, list.IndexOf(func(v int) bool { return v == 3 }) == 2)
+	ok(t, list.IndexOf(func(v int) bool { return v == 5 }) == -1)
+}
+
+func TestClear(t *testing.T) {
+	list := newList(1, 2, 3)
+	list.Clear()
+	assertListValues(t, list)
+	list.Add(4)
+	assertListValues(t, list, 4)
+}
+
+func TestIterators(t *testing.T) {
+	list := newList(1, 2, 3)
+	for h := range list.All() {
+		if v, _ := list.Value(h); v == 2 {
+			list.Remove(h)
+		}
+	}
+	assertListValues(t, list, 1, 3)
+
+	for range list.Values() {
+		break
+	}
+	indexes := []int{}
+	for i, v := range list.Backward() {
+		indexes = append(indexes, i)
+		ok(t, v == []int{1, 3}[i])
+	}
+	assertSlicesAreEqual(t, []int{1, 0}, indexes)
+}
+
+func TestAgainstSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	list := arena.New[int]()
+	model := []int{}
+	handles := []arena.Handle{}
+	for i := 0; i < 5000; i++ {
+		switch op := r.Intn(4); {
+		case op == 0:
+			handles = append(handles, list.Add(i))
+			model = append(model, i)
+		case op == 1:
+			handles = slices.Insert(handles, 0, list.AddToStart(i))
+			model = slices.Insert(model, 0, i)
+		case len(model) > 0:
+			index := r.Intn(len(model))
+			v, err := list.Remove(handles[index])
+			ok(t, err == nil && v == model[index])
+			handles = slices.Delete(handles, index, index+1)
+			model = slices.Delete(model, index, index+1)
+		}
+		if i%250 == 0 {
+			assertListValues(t, list, model...)
+		}
+	}
+	assertListValues(t, list, model...)
+}
+
+func BenchmarkAddArena(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		list := arena.New[int]()
+		for j := 0; j < benchSize; j++ {
+			list.Add(j)
+		}
+	}
+}
+
+func BenchmarkAddLinkedList(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		list := linkedlist.NewOf[int]()
+		for j := 0; j < benchSize; j++ {
+			list.Add(&linkedlist.NodeOf[int]{Value: j})
+		}
+	}
+}
+
+// The GC benchmarks time a full collection while a large list is live,
+// which is the cost the list adds to every collection cycle
+func BenchmarkGCArena(b *testing.B) {
+	list := arena.New[int]()
+	for j := 0; j < gcBenchSize; j++ {
+		list.Add(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runtime.GC()
+	}
+	runtime.KeepAlive(&list)
+}
+
+func BenchmarkGCLinkedList(b *testing.B) {
+	list := linkedlist.NewOf[int]()
+	for j := 0; j < gcBenchSize; j++ {
+		list.Add(&linkedlist.NodeOf[int]{Value: j})
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runtime.GC()
+	}
+	runtime.KeepAlive(&list)
+}
+
+const (
+	benchSize   = 10000
+	gcBenchSize = 1000000
+)
+
+func newList(values ...int) arena.List[int] {
+	list := arena.New[int]()
+	for _, v := range values {
+		list.Add(v)
+	}
+	return list
+}
+
+func assertListValues(t *testing.T, list arena.List[int], expected ...int) {
+	if list.Size() != len(expected) {
+		t.Fatal("list size is unexpected - got: ", list.Size(), " expected: ", len(expected))
+	}
+	assertSlicesAreEqual(t, expected, list.ToSlice())
+	for i := range expected {
+		if _, v := list.Get(i); v != expected[i] {
+			t.Fatal("Get is unexpected - index: ", i, " got: ", v, " expected: ", expected[i])
+		}
+	}
+	backward := []int{}
+	for h := list.Tail(); h != 0; h = list.Previous(h) {
+		v, _ := list.Value(h)
+		backward = append(backward, v)
+	}
+	slices.Reverse(backward)
+	assertSlicesAreEqual(t, expected, backward)
+}
+
+func ok(t *testing.T, b bool) {
+	if b == false {
+		t.Fatal("not ok")
+	}
+}
+
+func assertSlicesAreEqual(t *testing.T, expected, got []int) {
+	if len(expected) != len(got) {
+		t.Fatal("slices are not of equal length")
+	}
+	for i := 0; i < len(expected); i++ {
+		if expected[i] != got[i] {
+			t.Fatal("slice values are not equal")
+		}
+	}
+}
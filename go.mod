@@ -0,0 +1,3 @@
+module github.com/miketmoore/data-structures-go
+
+go 1.23
@@ -0,0 +1,93 @@
+// Package listtest holds test vectors shared by the list packages, so that
+// every list implementation is checked against the same cases.
+package listtest
+
+// Op names a list operation
+type Op int
+
+const (
+	// Add appends Value
+	Add Op = iota
+	// AddToStart prepends Value
+	AddToStart
+	// RemoveHead removes the first node, Result is its value or -1
+	RemoveHead
+	// RemoveTail removes the last node, Result is its value or -1
+	RemoveTail
+	// RemoveFirstOccurrence removes the first node equal to Value, Result is 1 on success
+	RemoveFirstOccurrence
+	// RemoveLastOccurrence removes the last node equal to Value, Result is 1 on success
+	RemoveLastOccurrence
+	// InsertBefore inserts Value before the first node equal to Index
+	InsertBefore
+	// InsertAfter inserts Value after the first node equal to Index
+	InsertAfter
+	// Get reads the node at Index, Result is its value or -1
+	Get
+	// Set replaces the node at Index with Value, Result is 1 on success
+	Set
+	// IndexOf finds the first node equal to Value, Result is its index
+	IndexOf
+	// LastIndexOf finds the last node equal to Value, Result is its index
+	LastIndexOf
+)
+
+// Vector is one operation applied to a list built from Initial, with the
+// result and list contents expected afterwards
+type Vector struct {
+	Name     string
+	Initial  []int
+	Op       Op
+	Index    int
+	Value    int
+	Result   int
+	Expected []int
+}
+
+// Vectors are the cases every list implementation must pass
+var Vectors = []Vector{
+	{Name: "add to empty", Initial: []int{}, Op: Add, Value: 1, Expected: []int{1}},
+	{Name: "add", Initial: []int{1, 2}, Op: Add, Value: 3, Expected: []int{1, 2, 3}},
+	{Name: "add to start of empty", Initial: []int{}, Op: AddToStart, Value: 1, Expected: []int{1}},
+	{Name: "add to start", Initial: []int{2, 3}, Op: AddToStart, Value: 1, Expected: []int{1, 2, 3}},
+
+	{Name: "remove head of empty", Initial: []int{}, Op: RemoveHead, Result: -1, Expected: []int{}},
+	{Name: "remove only head", Initial: []int{1}, Op: RemoveHead, Result: 1, Expected: []int{}},
+	{Name: "remove head", Initial: []int{1, 2, 3}, Op: RemoveHead, Result: 1, Expected: []int{2, 3}},
+	{Name: "remove tail of empty", Initial: []int{}, Op: RemoveTail, Result: -1, Expected: []int{}},
+	{Name: "remove only tail", Initial: []int{1}, Op: RemoveTail, Result: 1, Expected: []int{}},
+	{Name: "remove tail", Initial: []int{1, 2, 3}, Op: RemoveTail, Result: 3, Expected: []int{1, 2}},
+
+	{Name: "remove first occurrence of missing", Initial: []int{1, 2}, Op: RemoveFirstOccurrence, Value: 3, Result: 0, Expected: []int{1, 2}},
+	{Name: "remove first occurrence at head", Initial: []int{1, 2, 1}, Op: RemoveFirstOccurrence, Value: 1, Result: 1, Expected: []int{2, 1}},
+	{Name: "remove first occurrence in middle", Initial: []int{1, 2, 3, 2}, Op: RemoveFirstOccurrence, Value: 2, Result: 1, Expected: []int{1, 3, 2}},
+	{Name: "remove first occurrence at tail", Initial: []int{1, 2, 3}, Op: RemoveFirstOccurrence, Value: 3, Result: 1, Expected: []int{1, 2}},
+	{Name: "remove first occurrence of only node", Initial: []int{1}, Op: RemoveFirstOccurrence, Value: 1, Result: 1, Expected: []int{}},
+	{Name: "remove last occurrence of missing", Initial: []int{1, 2}, Op: RemoveLastOccurrence, Value: 3, Result: 0, Expected: []int{1, 2}},
+	{Name: "remove last occurrence at tail", Initial: []int{1, 2, 1}, Op: RemoveLastOccurrence, Value: 1, Result: 1, Expected: []int{1, 2}},
+	{Name: "remove last occurrence in middle", Initial: []int{2, 1, 2, 3}, Op: RemoveLastOccurrence, Value: 2, Result: 1, Expected: []int{2, 1, 3}},
+	{Name: "remove last occurrence at head", Initial: []int{1, 2, 3}, Op: RemoveLastOccurrence, Value: 1, Result: 1, Expected: []int{2, 3}},
+
+	{Name: "insert before in empty", Initial: []int{}, Op: InsertBefore, Index: 1, Value: 2, Expected: []int{}},
+	{Name: "insert before head", Initial: []int{2, 3}, Op: InsertBefore, Index: 2, Value: 1, Expected: []int{1, 2, 3}},
+	{Name: "insert before tail", Initial: []int{1, 3}, Op: InsertBefore, Index: 3, Value: 2, Expected: []int{1, 2, 3}},
+	{Name: "insert before missing", Initial: []int{1, 3}, Op: InsertBefore, Index: 4, Value: 2, Expected: []int{1, 3}},
+	{Name: "insert after head", Initial: []int{1, 3}, Op: InsertAfter, Index: 1, Value: 2, Expected: []int{1, 2, 3}},
+	{Name: "insert after tail", Initial: []int{1, 2}, Op: InsertAfter, Index: 2, Value: 3, Expected: []int{1, 2, 3}},
+	{Name: "insert after missing", Initial: []int{1, 2}, Op: InsertAfter, Index: 4, Value: 3, Expected: []int{1, 2}},
+
+	{Name: "get from empty", Initial: []int{}, Op: Get, Index: 0, Result: -1, Expected: []int{}},
+	{Name: "get head", Initial: []int{1, 2, 3}, Op: Get, Index: 0, Result: 1, Expected: []int{1, 2, 3}},
+	{Name: "get tail", Initial: []int{1, 2, 3}, Op: Get, Index: 2, Result: 3, Expected: []int{1, 2, 3}},
+	{Name: "get past end", Initial: []int{1, 2, 3}, Op: Get, Index: 3, Result: -1, Expected: []int{1, 2, 3}},
+	{Name: "set in empty", Initial: []int{}, Op: Set, Index: 0, Value: 1, Result: 0, Expected: []int{}},
+	{Name: "set head", Initial: []int{1, 2, 3}, Op: Set, Index: 0, Value: 9, Result: 1, Expected: []int{9, 2, 3}},
+	{Name: "set middle", Initial: []int{1, 2, 3}, Op: Set, Index: 1, Value: 9, Result: 1, Expected: []int{1, 9, 3}},
+	{Name: "set tail", Initial: []int{1, 2, 3}, Op: Set, Index: 2, Value: 9, Result: 1, Expected: []int{1, 2, 9}},
+	{Name: "set past end", Initial: []int{1, 2, 3}, Op: Set, Index: 3, Value: 9, Result: 0, Expected: []int{1, 2, 3}},
+
+	{Name: "index of missing", Initial: []int{1, 2}, Op: IndexOf, Value: 3, Result: -1, Expected: []int{1, 2}},
+	{Name: "index of", Initial: []int{1, 2, 2}, Op: IndexOf, Value: 2, Result: 1, Expected: []int{1, 2, 2}},
+	{Name: "last index of missing", Initial: []int{1, 2}, Op: LastIndexOf, Value: 3, Result: -1, Expected: []int{1, 2}},
+	{Name: "last index of", Initial: []int{1, 2, 2}, Op: LastIndexOf, Value: 2, Result: 2, Expected: []int{1, 2, 2}},
+}
@@ -0,0 +1,266 @@
+// Package intrusive provides a doubly linked list whose links are embedded
+// in the elements themselves, so adding an element allocates nothing and an
+// element can sit in several lists at once, one per embedded Link.
+package intrusive
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrNodeOwned is the panic value used when an element whose link already
+// belongs to a list is added to a list
+var ErrNodeOwned = errors.New("intrusive: element already belongs to a list")
+
+// ErrNodeNotInList is returned when an element passed to a list does not
+// belong to it
+var ErrNodeNotInList = errors.New("intrusive: element does not belong to this list")
+
+// Link is embedded in a struct of type T so that values of that struct can
+// be linked into a List. Embed one Link for every list an element needs to
+// be in at the same time.
+type Link[T any] struct {
+	next     *T
+	previous *T
+	list     *List[T]
+}
+
+// List is a doubly linked list of *T that stores its links inside T
+type List[T any] struct {
+	head *T
+	tail *T
+	size int
+	link func(*T) *Link[T]
+}
+
+// New returns an empty list that links elements through the Link returned
+// by link. The list must be used through the returned pointer, since
+// elements record which list they belong to.
+func New[T any](link func(*T) *Link[T]) *List[T] {
+	return &List[T]{link: link}
+}
+
+// Size returns the total number of elements in the list
+func (l *List[T]) Size() int {
+	return l.size
+}
+
+// Head returns the first element, if it exists
+func (l *List[T]) Head() *T {
+	return l.head
+}
+
+// Tail returns the last element, if it exists
+func (l *List[T]) Tail() *T {
+	return l.tail
+}
+
+// Next returns the element after x, if it exists
+func (l *List[T]) Next(x *T) *T {
+	return l.link(x).next
+}
+
+// Previous returns the element before x, if it exists
+func (l *List[T]) Previous(x *T) *T {
+	return l.link(x).previous
+}
+
+// Owns indicates if x is currently linked into this list
+func (l *List[T]) Owns(x *T) bool {
+	return x != nil && l.link(x).list == l
+}
+
+// linkAfter links a detached element after mark. A nil mark links it at the
+// start of the list.
+func (l *List[T]) linkAfter(x, mark *T) {
+	link := l.link(x)
+	if link.list != nil {
+		panic(ErrNodeOwned)
+	}
+	var next *T
+	if mark == nil {
+		next = l.head
+		l.head = x
+	} else {
+		next = l.link(mark).next
+		l.link(mark).next = x
+	}
+	if next == nil {
+		l.tail = x
+	} else {
+		l.link(next).previous = x
+	}
+	link.previous = mark
+	link.next = next
+	link.list = l
+	l.size++
+}
+
+// linkBefore links a detached element before mark. A nil mark links it at
+// the end of the list.
+func (l *List[T]) linkBefore(x, mark *T) {
+	if mark == nil {
+		l.linkAfter(x, l.tail)
+		return
+	}
+	l.linkAfter(x, l.link(mark).previous)
+}
+
+func (l *List[T]) unlink(x *T) {
+	link := l.link(x)
+	if link.previous == nil {
+		l.head = link.next
+	} else {
+		l.link(link.previous).next = link.next
+	}
+	if link.next == nil {
+		l.tail = link.previous
+	} else {
+		l.link(link.next).previous = link.previous
+	}
+	*link = Link[T]{}
+	l.size--
+}
+
+// Add appends x to the end of the list.
+// It panics with ErrNodeOwned if x already belongs to a list through the
+// same link.
+func (l *List[T]) Add(x *T) {
+	l.linkAfter(x, l.tail)
+}
+
+// AddToStart adds x to the beginning of the list.
+// It panics with ErrNodeOwned if x already belongs to a list through the
+// same link.
+func (l *List[T]) AddToStart(x *T) {
+	l.linkAfter(x, nil)
+}
+
+// InsertBefore inserts x directly before mark
+func (l *List[T]) InsertBefore(x, mark *T) error {
+	if !l.Owns(mark) {
+		return ErrNodeNotInList
+	}
+	l.linkBefore(x, mark)
+	return nil
+}
+
+// InsertAfter inserts x directly after mark
+func (l *List[T]) InsertAfter(x, mark *T) error {
+	if !l.Owns(mark) {
+		return ErrNodeNotInList
+	}
+	l.linkAfter(x, mark)
+	return nil
+}
+
+// Remove removes x from the list in constant time
+func (l *List[T]) Remove(x *T) error {
+	if !l.Owns(x) {
+		return ErrNodeNotInList
+	}
+	l.unlink(x)
+	return nil
+}
+
+// RemoveHead removes the first element from the list
+func (l *List[T]) RemoveHead() *T {
+	x := l.head
+	if x != nil {
+		l.unlink(x)
+	}
+	return x
+}
+
+// RemoveTail removes the last element from the list
+func (l *List[T]) RemoveTail() *T {
+	x := l.tail
+	if x != nil {
+		l.unlink(x)
+	}
+	return x
+}
+
+// MoveToFront moves x to the beginning of the list
+func (l *List[T]) MoveToFront(x *T) error {
+	if !l.Owns(x) {
+		return ErrNodeNotInList
+	}
+	if x != l.head {
+		l.unlink(x)
+		l.linkAfter(x, nil)
+	}
+	return nil
+}
+
+// MoveToBack moves x to the end of the list
+func (l *List[T]) MoveToBack(x *T) error {
+	if !l.Owns(x) {
+		return ErrNodeNotInList
+	}
+	if x != l.tail {
+		l.unlink(x)
+		l.linkAfter(x, l.tail)
+	}
+	return nil
+}
+
+// MoveBefore moves x so that it comes directly before mark
+func (l *List[T]) MoveBefore(x, mark *T) error {
+	if !l.Owns(x) || !l.Owns(mark) {
+		return ErrNodeNotInList
+	}
+	if x != mark && l.link(x).next != mark {
+		l.unlink(x)
+		l.linkBefore(x, mark)
+	}
+	return nil
+}
+
+// MoveAfter moves x so that it comes directly after mark
+func (l *List[T]) MoveAfter(x, mark *T) error {
+	if !l.Owns(x) || !l.Owns(mark) {
+		return ErrNodeNotInList
+	}
+	if x != mark && l.link(x).previous != mark {
+		l.unlink(x)
+		l.linkAfter(x, mark)
+	}
+	return nil
+}
+
+// Clear removes all elements from the list
+func (l *List[T]) Clear() {
+	for l.head != nil {
+		l.unlink(l.head)
+	}
+}
+
+// All returns a sequence of the elements from head to tail.
+// The element being visited may be removed from the list during iteration.
+func (l *List[T]) All() iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		for x := l.head; x != nil; {
+			next := l.link(x).next
+			if !yield(x) {
+				return
+			}
+			x = next
+		}
+	}
+}
+
+// Backward returns a sequence of index and element pairs from tail to head.
+// The element being visited may be removed from the list during iteration.
+func (l *List[T]) Backward() iter.Seq2[int, *T] {
+	return func(yield func(int, *T) bool) {
+		i := l.size - 1
+		for x := l.tail; x != nil; i-- {
+			previous := l.link(x).previous
+			if !yield(i, x) {
+				return
+			}
+			x = previous
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package intrusive_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/intrusive"
+)
+
+// entry can be in an LRU list and a per-tenant list at the same time
+type entry struct {
+	key    int
+	lru    intrusive.Link[entry]
+	tenant intrusive.Link[entry]
+}
+
+func lruLink(e *entry) *intrusive.Link[entry] {
+	return &e.lru
+}
+
+func tenantLink(e *entry) *intrusive.Link[entry] {
+	return &e.tenant
+}
+
+func newEntries(keys ...int) []*entry {
+	entries := make([]*entry, len(keys))
+	for i, key := range keys {
+		entries[i] = &entry{key: key}
+	}
+	return entries
+}
+
+func newList(entries ...*entry) *intrusive.List[entry] {
+	list := intrusive.New(lruLink)
+	for _, e := range entries {
+		list.Add(e)
+	}
+	return list
+}
+
+func TestAdd(t *testing.T) {
+	entries := newEntries(1, 2, 3)
+	list := intrusive.New(lruLink)
+	assertListKeys(t, list)
+
+	list.Add(entries[1])
+	list.Add(entries[2])
+	list.AddToStart(entries[0])
+	assertListKeys(t, list, 1, 2, 3)
+
+	assertPanics(t, intrusive.ErrNodeOwned, func() { list.Add(entries[0]) })
+	other := intrusive.New(lruLink)
+	assertPanics(t, intrusive.ErrNodeOwned, func() { other.AddToStart(entries[0]) })
+	assertListKeys(t, list, 1, 2, 3)
+}
+
+func TestInsert(t *testing.T) {
+	entries := newEntries(1, 2, 3, 4)
+	list := newList(entries[1])
+	ok(t, list.InsertBefore(entries[0], entries[1]) == nil)
+	ok(t, list.InsertAfter(entries[3], entries[1]) == nil)
+	ok(t, list.InsertAfter(entries[2], entries[1]) == nil)
+	assertListKeys(t, list, 1, 2, 3, 4)
+
+	stray := &entry{key: 5}
+	ok(t, list.InsertBefore(stray, &entry{}) == intrusive.ErrNodeNotInList)
+	ok(t, list.InsertAfter(stray, nil) == intrusive.ErrNodeNotInList)
+	assertListKeys(t, list, 1, 2, 3, 4)
+}
+
+func TestRemove(t *testing.T) {
+	entries := newEntries(1, 2, 3, 4)
+	list := newList(entries...)
+
+	ok(t, list.Remove(entries[1]) == nil)
+	assertListKeys(t, list, 1, 3, 4)
+	ok(t, list.Remove(entries[1]) == intrusive.ErrNodeNotInList)
+
+	ok(t, list.RemoveHead() == entries[0])
+	ok(t, list.RemoveTail() == entries[3])
+	assertListKeys(t, list, 3)
+
+	list.Clear()
+	assertListKeys(t, list)
+	ok(t, list.RemoveHead() == nil)
+	ok(t, list.RemoveTail() == nil)
+
+	// removed entries can be added again
+	list.Add(entries[3])
+	list.Add(entries[0])
+	assertListKeys(t, list, 4, 1)
+}
+
+func TestMove(t *testing.T) {
+	entries := newEntries(1, 2, 3, 4)
+	list := newList(entries...)
+
+	ok(t, list.MoveToFront(entries[3]) == nil)
+	assertListKeys(t, list, 4, 1, 2, 3)
+	ok(t, list.MoveToBack(entries[3]) == nil)
+	assertListKeys(t, list, 1, 2, 3, 4)
+	ok(t, list.MoveBefore(entries[3], entries[1]) == nil)
+	assertListKeys(t, list, 1, 4, 2, 3)
+	ok(t, list.MoveAfter(entries[0], entries[2]) == nil)
+	assertListKeys(t, list, 4, 2, 3, 1)
+	ok(t, list.MoveAfter(entries[0], entries[0]) == nil)
+	assertListKeys(t, list, 4, 2, 3, 1)
+
+	other := newEntries(5)
+	ok(t, list.MoveToFront(other[0]) == intrusive.ErrNodeNotInList)
+	ok(t, list.MoveToBack(other[0]) == intrusive.ErrNodeNotInList)
+	ok(t, list.MoveBefore(other[0], entries[0]) == intrusive.ErrNodeNotInList)
+	ok(t, list.MoveAfter(entries[0], other[0]) == intrusive.ErrNodeNotInList)
+	assertListKeys(t, list, 4, 2, 3, 1)
+}
+
+func TestSeveralLists(t *testing.T) {
+	entries := newEntries(1, 2, 3, 4)
+	lru := newList(entries...)
+	tenantA := intrusive.New(tenantLink)
+	tenantB := intrusive.New(tenantLink)
+	tenantA.Add(entries[0])
+	tenantA.Add(entries[2])
+	tenantB.Add(entries[1])
+	tenantB.Add(entries[3])
+
+	ok(t, lru.MoveToFront(entries[2]) == nil)
+	assertListKeys(t, lru, 3, 1, 2, 4)
+	assertListKeys(t, tenantA, 1, 3)
+
+	// evicting from the LRU list also drops the entry from its tenant
+	evicted := lru.RemoveTail()
+	ok(t, tenantB.Remove(evicted) == nil)
+	ok(t, tenantA.Remove(evicted) == intrusive.ErrNodeNotInList)
+	assertListKeys(t, lru, 3, 1, 2)
+	assertListKeys(t, tenantB, 2)
+	ok(t, lru.Owns(entries[1]) && tenantB.Owns(entries[1]) && !tenantA.Owns(entries[1]))
+}
+
+func TestAll(t *testing.T) {
+	entries := newEntries(1, 2, 3, 4)
+	list := newList(entries...)
+	for e := range list.All() {
+		if e.key%2 == 0 {
+			ok(t, list.Remove(e) == nil)
+		}
+	}
+	assertListKeys(t, list, 1, 3)
+
+	count := 0
+	for range list.All() {
+		count++
+		break
+	}
+	ok(t, count == 1)
+}
+
+func TestBackward(t *testing.T) {
+	list := newList(newEntries(1, 2, 3)...)
+	indexes := []int{}
+	keys := []int{}
+	for i, e := range list.Backward() {
+		indexes = append(indexes, i)
+		keys = append(keys, e.key)
+	}
+	assertSlicesAreEqual(t, []int{2, 1, 0}, indexes)
+	assertSlicesAreEqual(t, []int{3, 2, 1}, keys)
+}
+
+func BenchmarkAddRemove(b *testing.B) {
+	b.ReportAllocs()
+	list := intrusive.New(lruLink)
+	e := &entry{}
+	for i := 0; i < b.N; i++ {
+		list.Add(e)
+		list.RemoveHead()
+	}
+}
+
+func assertListKeys(t *testing.T, list *intrusive.List[entry], expected ...int) {
+	if list.Size() != len(expected) {
+		t.Fatal("list size is unexpected - got: ", list.Size(), " expected: ", len(expected))
+	}
+	ascending := list.Head()
+	descending := list.Tail()
+	for i := 0; i < len(expected); i++ {
+		if ascending.key != expected[i] {
+			t.Fatal("ascending key is unexpected - index: ", i, " got: ", ascending.key, " expected: ", expected[i])
+		}
+		if descending.key != expected[len(expected)-1-i] {
+			t.Fatal("descending key is unexpected - index: ", i, " got: ", descending.key)
+		}
+		ascending = list.Next(ascending)
+		descending = list.Previous(descending)
+	}
+	if ascending != nil || descending != nil {
+		t.Fatal("list has more elements than expected")
+	}
+}
+
+func assertPanics(t *testing.T, expected interface{}, fn func()) {
+	defer func() {
+		if r := recover(); r != expected {
+			t.Fatal("unexpected panic - got: ", r, " expected: ", expected)
+		}
+	}()
+	fn()
+}
+
+func ok(t *testing.T, b bool) {
+	if b == false {
+		t.Fatal("not ok")
+	}
+}
+
+func assertSlicesAreEqual(t *testing.T, expected, got []int) {
+	if len(expected) != len(got) {
+		t.Fatal("slices are not of equal length")
+	}
+	for i := 0; i < len(expected); i++ {
+		if expected[i] != got[i] {
+			t.Fatal("slice values are not equal")
+		}
+	}
+}
@@ -0,0 +1,29 @@
+//go:build !linkedlistdebug
+
+// Validating after every mutation allocates, so the allocation tests only
+// run in normal builds.
+
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func TestValueMethodsDoNotAllocate(t *testing.T) {
+	list := linkedlist.NewOf[int]()
+	for i := 0; i < 100; i++ {
+		list.AddValue(i)
+	}
+	for i := 0; i < 100; i++ {
+		list.RemoveHeadValue()
+	}
+	allocs := testing.AllocsPerRun(1000, func() {
+		list.AddValue(1)
+		list.AddValueToStart(2)
+		list.RemoveHeadValue()
+		list.RemoveTailValue()
+	})
+	ok(t, allocs == 0)
+}
@@ -0,0 +1,98 @@
+package linkedlist
+
+// RemoveIf removes every node that matches and returns how many were removed
+func (l *LinkedListOf[T]) RemoveIf(matcher MatcherFnOf[T]) int {
+	removed := 0
+	for node := l.Head; node != nil; {
+		next := node.next
+		if matcher(node) {
+			l.unlink(node)
+			removed++
+		}
+		node = next
+	}
+	return removed
+}
+
+// RetainIf removes every node that does not match and returns how many were
+// removed
+func (l *LinkedListOf[T]) RetainIf(matcher MatcherFnOf[T]) int {
+	return l.RemoveIf(func(node *NodeOf[T]) bool {
+		return !matcher(node)
+	})
+}
+
+// Filter returns a new list of new nodes holding the values of the nodes
+// that match
+func (l *LinkedListOf[T]) Filter(matcher MatcherFnOf[T]) LinkedListOf[T] {
+	filtered := NewOf[T]()
+	for node := l.Head; node != nil; node = node.next {
+		if matcher(node) {
+			filtered.Add(&NodeOf[T]{Value: node.Value})
+		}
+	}
+	return filtered
+}
+
+// Map returns a new list made of the nodes returned by mapper for each node
+// in this list. It panics with ErrNodeOwned if mapper returns a node that
+// already belongs to a list.
+func (l *LinkedListOf[T]) Map(mapper func(node *NodeOf[T]) *NodeOf[T]) LinkedListOf[T] {
+	mapped := NewOf[T]()
+	for node := l.Head; node != nil; node = node.next {
+		mapped.Add(mapper(node))
+	}
+	return mapped
+}
+
+// Reduce folds the nodes of the list from Head to Tail into a single value
+func Reduce[T, A any](l *LinkedListOf[T], initial A, reducer func(acc A, node *NodeOf[T]) A) A {
+	acc := initial
+	for node := l.Head; node != nil; node = node.next {
+		acc = reducer(acc, node)
+	}
+	return acc
+}
+
+// AnyMatch indicates if at least one node matches
+func (l *LinkedListOf[T]) AnyMatch(matcher MatcherFnOf[T]) bool {
+	found, _ := l.Find(matcher)
+	return found
+}
+
+// AllMatch indicates if every node matches. It is true for an empty list.
+func (l *LinkedListOf[T]) AllMatch(matcher MatcherFnOf[T]) bool {
+	for node := l.Head; node != nil; node = node.next {
+		if !matcher(node) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneMatch indicates if no node matches. It is true for an empty list.
+func (l *LinkedListOf[T]) NoneMatch(matcher MatcherFnOf[T]) bool {
+	return !l.AnyMatch(matcher)
+}
+
+// Count returns the number of nodes that match
+func (l *LinkedListOf[T]) Count(matcher MatcherFnOf[T]) int {
+	count := 0
+	for node := l.Head; node != nil; node = node.next {
+		if matcher(node) {
+			count++
+		}
+	}
+	return count
+}
+
+// FindAll returns every node that matches, in list order
+func (l *LinkedListOf[T]) FindAll(matcher MatcherFnOf[T]) []*NodeOf[T] {
+	var found []*NodeOf[T]
+	for node := l.Head; node != nil; node = node.next {
+		if matcher(node) {
+			found = append(found, node)
+		}
+	}
+	return found
+}
@@ -0,0 +1,95 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func isEven(node *linkedlist.Node) bool {
+	return node.Value.(int)%2 == 0
+}
+
+func TestRemoveIf(t *testing.T) {
+	list := newList(2, 1, 2, 3, 4, 4)
+	ok(t, list.RemoveIf(isEven) == 4)
+	assertListNodes(t, list, 1, 3)
+	ok(t, list.RemoveIf(isEven) == 0)
+	assertListNodes(t, list, 1, 3)
+
+	list = newList(2, 4)
+	ok(t, list.RemoveIf(isEven) == 2)
+	assertListIsEmpty(t, list)
+}
+
+func TestRetainIf(t *testing.T) {
+	list := newList(1, 2, 3, 4, 5)
+	ok(t, list.RetainIf(isEven) == 3)
+	assertListNodes(t, list, 2, 4)
+}
+
+func TestFilter(t *testing.T) {
+	list := newList(1, 2, 3, 4)
+	filtered := list.Filter(isEven)
+	assertListNodes(t, filtered, 2, 4)
+	assertListNodes(t, list, 1, 2, 3, 4)
+	ok(t, !list.Owns(filtered.Head))
+
+	empty := list.Filter(func(node *linkedlist.Node) bool { return false })
+	assertListIsEmpty(t, empty)
+}
+
+func TestMap(t *testing.T) {
+	list := newList(1, 2, 3)
+	mapped := list.Map(func(node *linkedlist.Node) *linkedlist.Node {
+		return &linkedlist.Node{Value: node.Value.(int) * 2}
+	})
+	assertListNodes(t, mapped, 2, 4, 6)
+	assertListNodes(t, list, 1, 2, 3)
+
+	assertPanics(t, linkedlist.ErrNodeOwned, func() {
+		list.Map(func(node *linkedlist.Node) *linkedlist.Node { return node })
+	})
+}
+
+func TestReduce(t *testing.T) {
+	list := newList(1, 2, 3, 4)
+	sum := linkedlist.Reduce(&list, 0, func(acc int, node *linkedlist.Node) int {
+		return acc + node.Value.(int)
+	})
+	ok(t, sum == 10)
+
+	joined := linkedlist.Reduce(&list, "", func(acc string, node *linkedlist.Node) string {
+		return acc + string(rune('0'+node.Value.(int)))
+	})
+	ok(t, joined == "1234")
+}
+
+func TestMatchPredicates(t *testing.T) {
+	list := newList(1, 2, 3)
+	ok(t, list.AnyMatch(isEven))
+	ok(t, !list.AllMatch(isEven))
+	ok(t, !list.NoneMatch(isEven))
+	ok(t, list.Count(isEven) == 1)
+
+	evens := newList(2, 4)
+	ok(t, evens.AllMatch(isEven))
+	ok(t, evens.Count(isEven) == 2)
+
+	empty := newList()
+	ok(t, !empty.AnyMatch(isEven))
+	ok(t, empty.AllMatch(isEven))
+	ok(t, empty.NoneMatch(isEven))
+	ok(t, empty.Count(isEven) == 0)
+}
+
+func TestFindAll(t *testing.T) {
+	list := newList(1, 2, 3, 4)
+	found := list.FindAll(isEven)
+	ok(t, len(found) == 2)
+	assertNodeValue(t, 2, found[0])
+	assertNodeValue(t, 4, found[1])
+	ok(t, list.Owns(found[0]))
+
+	ok(t, len(list.FindAll(func(node *linkedlist.Node) bool { return false })) == 0)
+}
@@ -0,0 +1,145 @@
+package linkedlist
+
+import "fmt"
+
+// Equal indicates if both lists have the same size and eq reports every pair
+// of nodes at the same index as equal
+func (l *LinkedListOf[T]) Equal(other *LinkedListOf[T], eq func(a, b *NodeOf[T]) bool) bool {
+	if l.size != other.size {
+		return false
+	}
+	for a, b := l.Head, other.Head; a != nil && b != nil; a, b = a.next, b.next {
+		if !eq(a, b) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare compares the lists lexicographically using cmp to order nodes. It
+// returns a negative number if l sorts before other, zero if they are equal
+// and a positive number if l sorts after other. A list sorts before any
+// longer list that it is a prefix of.
+func (l *LinkedListOf[T]) Compare(other *LinkedListOf[T], cmp func(a, b *NodeOf[T]) int) int {
+	a, b := l.Head, other.Head
+	for ; a != nil && b != nil; a, b = a.next, b.next {
+		if c := cmp(a, b); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Hash combines the hash h of every node in order, so that lists which are
+// Equal under a matching equality have the same hash
+func (l *LinkedListOf[T]) Hash(h func(node *NodeOf[T]) uint64) uint64 {
+	// FNV-1a, taking a whole node hash per step instead of a byte
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+	hash := uint64(offset)
+	for node := l.Head; node != nil; node = node.next {
+		hash ^= h(node)
+		hash *= prime
+	}
+	return hash
+}
+
+// EditKind describes one step of an edit script
+type EditKind int
+
+const (
+	// Keep means the node appears in both lists
+	Keep EditKind = iota
+	// Delete means the node only appears in the first list
+	Delete
+	// Insert means the node only appears in the second list
+	Insert
+)
+
+var editKindNames = [...]string{
+	Keep:   "keep",
+	Delete: "delete",
+	Insert: "insert",
+}
+
+func (k EditKind) String() string {
+	if k < 0 || int(k) >= len(editKindNames) {
+		return fmt.Sprintf("EditKind(%d)", int(k))
+	}
+	return editKindNames[k]
+}
+
+// EditOf is one step of an edit script between lists holding values of
+// type T. Kept and deleted nodes belong to the first list, inserted nodes
+// to the second.
+type EditOf[T any] struct {
+	Kind EditKind
+	Node *NodeOf[T]
+}
+
+// String formats the edit like a line of a unified diff
+func (e EditOf[T]) String() string {
+	prefix := " "
+	switch e.Kind {
+	case Delete:
+		prefix = "-"
+	case Insert:
+		prefix = "+"
+	}
+	return fmt.Sprintf("%s%v", prefix, e.Node.Value)
+}
+
+// Diff returns the shortest edit script that turns l into other, using eq to
+// match nodes. It takes O(n*m) time and space.
+func (l *LinkedListOf[T]) Diff(other *LinkedListOf[T], eq func(a, b *NodeOf[T]) bool) []EditOf[T] {
+	a, b := l.ToSlice(), other.ToSlice()
+
+	// common[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:]
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if eq(a[i], b[j]) {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]EditOf[T], 0, len(a)+len(b)-common[0][0])
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case eq(a[i], b[j]):
+			edits = append(edits, EditOf[T]{Kind: Keep, Node: a[i]})
+			i++
+			j++
+		case common[i+1][j] >= common[i][j+1]:
+			edits = append(edits, EditOf[T]{Kind: Delete, Node: a[i]})
+			i++
+		default:
+			edits = append(edits, EditOf[T]{Kind: Insert, Node: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		edits = append(edits, EditOf[T]{Kind: Delete, Node: a[i]})
+	}
+	for ; j < len(b); j++ {
+		edits = append(edits, EditOf[T]{Kind: Insert, Node: b[j]})
+	}
+	return edits
+}
@@ -0,0 +1,101 @@
+package linkedlist_test
+
+import (
+	"cmp"
+	"fmt"
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func eqInt(a, b *linkedlist.Node) bool {
+	return a.Value == b.Value
+}
+
+func cmpInt(a, b *linkedlist.Node) int {
+	return cmp.Compare(a.Value.(int), b.Value.(int))
+}
+
+func hashInt(node *linkedlist.Node) uint64 {
+	return uint64(node.Value.(int))
+}
+
+func TestEqual(t *testing.T) {
+	a := newList(1, 2, 3)
+	b := newList(1, 2, 3)
+	ok(t, a.Equal(&b, eqInt))
+	c := newList(1, 2, 4)
+	ok(t, !a.Equal(&c, eqInt))
+	d := newList(1, 2)
+	ok(t, !a.Equal(&d, eqInt))
+	e, f := newList(), newList()
+	ok(t, e.Equal(&f, eqInt))
+}
+
+func TestCompare(t *testing.T) {
+	for _, tc := range []struct {
+		a, b     []int
+		expected int
+	}{
+		{[]int{}, []int{}, 0},
+		{[]int{1, 2}, []int{1, 2}, 0},
+		{[]int{1, 2}, []int{1, 3}, -1},
+		{[]int{1, 3}, []int{1, 2}, 1},
+		{[]int{1}, []int{1, 2}, -1},
+		{[]int{1, 2}, []int{1}, 1},
+		{[]int{}, []int{0}, -1},
+		{[]int{2}, []int{1, 5}, 1},
+	} {
+		a, b := newList(tc.a...), newList(tc.b...)
+		ok(t, a.Compare(&b, cmpInt) == tc.expected)
+	}
+}
+
+func TestHash(t *testing.T) {
+	a := newList(1, 2, 3)
+	b := newList(1, 2, 3)
+	ok(t, a.Hash(hashInt) == b.Hash(hashInt))
+	c := newList(3, 2, 1)
+	ok(t, a.Hash(hashInt) != c.Hash(hashInt))
+	d := newList(1, 2)
+	ok(t, a.Hash(hashInt) != d.Hash(hashInt))
+	e, f := newList(), newList(0)
+	ok(t, e.Hash(hashInt) != f.Hash(hashInt))
+}
+
+func TestDiff(t *testing.T) {
+	for _, tc := range []struct {
+		a, b     []int
+		expected string
+	}{
+		{[]int{}, []int{}, "[]"},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, "[ 1  2  3]"},
+		{[]int{}, []int{1, 2}, "[+1 +2]"},
+		{[]int{1, 2}, []int{}, "[-1 -2]"},
+		{[]int{1, 2, 3}, []int{1, 3}, "[ 1 -2  3]"},
+		{[]int{1, 3}, []int{1, 2, 3}, "[ 1 +2  3]"},
+		{[]int{1, 2, 3}, []int{1, 4, 3}, "[ 1 -2 +4  3]"},
+		{[]int{1, 2, 3, 4, 5}, []int{2, 4, 6}, "[-1  2 -3  4 -5 +6]"},
+	} {
+		a, b := newList(tc.a...), newList(tc.b...)
+		edits := a.Diff(&b, eqInt)
+		if got := fmt.Sprint(edits); got != tc.expected {
+			t.Fatal("diff is unexpected - got: ", got, " expected: ", tc.expected)
+		}
+		for _, edit := range edits {
+			switch edit.Kind {
+			case linkedlist.Keep, linkedlist.Delete:
+				ok(t, a.Owns(edit.Node))
+			case linkedlist.Insert:
+				ok(t, b.Owns(edit.Node))
+			}
+		}
+	}
+}
+
+func TestEditKindString(t *testing.T) {
+	ok(t, linkedlist.Keep.String() == "keep")
+	ok(t, linkedlist.Delete.String() == "delete")
+	ok(t, linkedlist.Insert.String() == "insert")
+	ok(t, linkedlist.EditKind(9).String() == "EditKind(9)")
+}
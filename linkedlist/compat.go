@@ -0,0 +1,50 @@
+package linkedlist
+
+// LinkedList is a linked list holding values of any type.
+// It is kept so that code written before LinkedListOf existed keeps compiling.
+type LinkedList = LinkedListOf[interface{}]
+
+// Node is one link in a LinkedList
+type Node = NodeOf[interface{}]
+
+// Iterator is the iterator for a LinkedList
+type Iterator = IteratorOf[interface{}]
+
+// MatcherFn represents a matcher
+type MatcherFn = func(*Node) bool
+
+// LessFn reports whether node a should sort before node b
+type LessFn = func(a, b *Node) bool
+
+// ListIterator is the list iterator for a LinkedList
+type ListIterator = ListIteratorOf[interface{}]
+
+// SubList is a live view over a range of a LinkedList
+type SubList = SubListOf[interface{}]
+
+// Guarded is the guarded traversal of a LinkedList
+type Guarded = GuardedOf[interface{}]
+
+// Edit is one step of an edit script between two LinkedLists
+type Edit = EditOf[interface{}]
+
+// SortedList is a LinkedList kept in order by a comparator
+type SortedList = SortedListOf[interface{}]
+
+// Ring is a circular linked list holding values of any type
+type Ring = RingOf[interface{}]
+
+// New returns an empty linked list
+func New() LinkedList {
+	return LinkedList{}
+}
+
+// NewRing returns an empty ring
+func NewRing() Ring {
+	return Ring{}
+}
+
+// NewSorted returns an empty sorted list ordered by compare
+func NewSorted(compare func(a, b interface{}) int, policy DuplicatePolicy) SortedList {
+	return NewSortedOf(compare, policy)
+}
@@ -0,0 +1,118 @@
+package linkedlist
+
+// DetectCycle follows next links from head using Brent's algorithm. If the
+// chain loops back on itself it returns the first node of the loop and the
+// number of nodes in it, otherwise it returns nil and 0.
+func DetectCycle[T any](head *NodeOf[T]) (*NodeOf[T], int) {
+	if head == nil {
+		return nil, 0
+	}
+	power, length := 1, 1
+	tortoise, hare := head, head.next
+	for hare != tortoise {
+		if hare == nil {
+			return nil, 0
+		}
+		if power == length {
+			tortoise = hare
+			power *= 2
+			length = 0
+		}
+		hare = hare.next
+		length++
+	}
+
+	// Start one pointer length nodes ahead, they meet at the start of the loop
+	tortoise, hare = head, head
+	for i := 0; i < length; i++ {
+		hare = hare.next
+	}
+	for tortoise != hare {
+		tortoise = tortoise.next
+		hare = hare.next
+	}
+	return tortoise, length
+}
+
+// BreakCycle cuts the next link that closes a loop in the chain starting at
+// head, so the last node of the loop ends the chain. It returns false if
+// the chain has no loop.
+func BreakCycle[T any](head *NodeOf[T]) bool {
+	start, length := DetectCycle(head)
+	if start == nil {
+		return false
+	}
+	last := start
+	for i := 1; i < length; i++ {
+		last = last.next
+	}
+	last.next = nil
+	if start.previous == last {
+		start.previous = nil
+	}
+	return true
+}
+
+// GuardedOf traverses a list holding values of type T without trusting its
+// links. Every traversal stops after Size steps and returns ErrCycle if the
+// chain from Head has not ended by then.
+type GuardedOf[T any] struct {
+	list *LinkedListOf[T]
+}
+
+// Guarded returns a guarded traversal of the list
+func (l *LinkedListOf[T]) Guarded() GuardedOf[T] {
+	return GuardedOf[T]{list: l}
+}
+
+// Walk calls fn for each node from Head to Tail until fn returns false
+func (g GuardedOf[T]) Walk(fn func(node *NodeOf[T]) bool) error {
+	steps := 0
+	for node := g.list.Head; node != nil; node = node.next {
+		if steps == g.list.size {
+			return ErrCycle
+		}
+		steps++
+		if !fn(node) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Find finds the first node that matches
+func (g GuardedOf[T]) Find(matcher MatcherFnOf[T]) (bool, *NodeOf[T], error) {
+	var found *NodeOf[T]
+	err := g.Walk(func(node *NodeOf[T]) bool {
+		if matcher(node) {
+			found = node
+			return false
+		}
+		return true
+	})
+	return found != nil, found, err
+}
+
+// IndexOf returns the index of the first node that matches, or -1
+func (g GuardedOf[T]) IndexOf(matcher MatcherFnOf[T]) (int, error) {
+	index, i := -1, 0
+	err := g.Walk(func(node *NodeOf[T]) bool {
+		if matcher(node) {
+			index = i
+			return false
+		}
+		i++
+		return true
+	})
+	return index, err
+}
+
+// ToSlice returns a slice of the nodes in the list
+func (g GuardedOf[T]) ToSlice() ([]*NodeOf[T], error) {
+	nodes := make([]*NodeOf[T], 0, g.list.size)
+	err := g.Walk(func(node *NodeOf[T]) bool {
+		nodes = append(nodes, node)
+		return true
+	})
+	return nodes, err
+}
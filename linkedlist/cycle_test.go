@@ -0,0 +1,97 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+// chain links detached nodes holding 0..n-1 by hand and returns them
+func chain(n int) []*linkedlist.Node {
+	nodes := make([]*linkedlist.Node, n)
+	for i := range nodes {
+		nodes[i] = &linkedlist.Node{Value: i}
+	}
+	for i := 1; i < n; i++ {
+		nodes[i-1].LinkNext(nodes[i])
+		nodes[i].LinkPrevious(nodes[i-1])
+	}
+	return nodes
+}
+
+func TestDetectCycle(t *testing.T) {
+	start, length := linkedlist.DetectCycle[interface{}](nil)
+	ok(t, start == nil && length == 0)
+
+	nodes := chain(5)
+	start, length = linkedlist.DetectCycle(nodes[0])
+	ok(t, start == nil && length == 0)
+
+	for _, tc := range []struct{ size, loopTo int }{{1, 0}, {2, 0}, {5, 0}, {5, 2}, {5, 4}, {40, 17}} {
+		nodes := chain(tc.size)
+		nodes[tc.size-1].LinkNext(nodes[tc.loopTo])
+		start, length := linkedlist.DetectCycle(nodes[0])
+		ok(t, start == nodes[tc.loopTo])
+		ok(t, length == tc.size-tc.loopTo)
+	}
+}
+
+func TestBreakCycle(t *testing.T) {
+	ok(t, !linkedlist.BreakCycle(chain(3)[0]))
+
+	nodes := chain(5)
+	nodes[4].LinkNext(nodes[1])
+	nodes[1].LinkPrevious(nodes[4])
+	ok(t, linkedlist.BreakCycle(nodes[0]))
+	start, _ := linkedlist.DetectCycle(nodes[0])
+	ok(t, start == nil)
+	ok(t, nodes[4].Next() == nil)
+	ok(t, nodes[1].Previous() == nil)
+
+	nodes = chain(1)
+	nodes[0].LinkNext(nodes[0])
+	ok(t, linkedlist.BreakCycle(nodes[0]))
+	ok(t, nodes[0].Next() == nil)
+}
+
+func TestGuarded(t *testing.T) {
+	list := newList(1, 2, 3)
+	guarded := list.Guarded()
+
+	found, node, err := guarded.Find(func(node *linkedlist.Node) bool { return node.Value == 2 })
+	ok(t, err == nil && found && node.Value == 2)
+	found, _, err = guarded.Find(func(node *linkedlist.Node) bool { return node.Value == 4 })
+	ok(t, err == nil && !found)
+
+	index, err := guarded.IndexOf(func(node *linkedlist.Node) bool { return node.Value == 3 })
+	ok(t, err == nil && index == 2)
+
+	nodes, err := guarded.ToSlice()
+	ok(t, err == nil && len(nodes) == 3)
+
+	visited := 0
+	err = guarded.Walk(func(node *linkedlist.Node) bool {
+		visited++
+		return visited < 2
+	})
+	ok(t, err == nil && visited == 2)
+}
+
+func TestGuardedStopsOnCycle(t *testing.T) {
+	list := newList(1, 2, 3)
+	linkedlist.ForceNext(list.Tail, list.Head)
+	guarded := list.Guarded()
+
+	found, _, err := guarded.Find(func(node *linkedlist.Node) bool { return node.Value == 4 })
+	ok(t, err == linkedlist.ErrCycle && !found)
+	index, err := guarded.IndexOf(func(node *linkedlist.Node) bool { return node.Value == 4 })
+	ok(t, err == linkedlist.ErrCycle && index == -1)
+	nodes, err := guarded.ToSlice()
+	ok(t, err == linkedlist.ErrCycle && len(nodes) == 3)
+
+	start, length := linkedlist.DetectCycle(list.Head)
+	ok(t, start == list.Head && length == 3)
+	ok(t, linkedlist.BreakCycle(list.Head))
+	_, err = guarded.ToSlice()
+	ok(t, err == nil)
+}
@@ -0,0 +1,6 @@
+//go:build linkedlistdebug
+
+package linkedlist
+
+// debug enables validating lists after every mutation
+const debug = true
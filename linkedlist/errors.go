@@ -0,0 +1,48 @@
+package linkedlist
+
+import "errors"
+
+// ErrNodeNotInList is returned when a node passed to a list does not belong to it
+var ErrNodeNotInList = errors.New("linkedlist: node does not belong to this list")
+
+// ErrNodeOwned is the panic value used whenever a node that already belongs
+// to a list is added to a list, set into one or relinked by hand. Adding an
+// owned node is a programming error, so no method returns it as an error.
+var ErrNodeOwned = errors.New("linkedlist: node already belongs to a list")
+
+// ErrIndexOutOfRange is returned when an index is outside the bounds of a list
+var ErrIndexOutOfRange = errors.New("linkedlist: index out of range")
+
+// ErrNoCurrentNode is returned when a ListIterator is asked to modify the
+// last returned node before Next or Previous has been called, or after the
+// node was already removed
+var ErrNoCurrentNode = errors.New("linkedlist: iterator has no current node")
+
+// ErrConcurrentModification is reported by an iterator when its list was
+// structurally modified by something other than the iterator itself
+var ErrConcurrentModification = errors.New("linkedlist: list modified during iteration")
+
+// ErrInvalidRange is returned when a range of nodes does not describe a run
+// of consecutive nodes in a list
+var ErrInvalidRange = errors.New("linkedlist: invalid node range")
+
+// ErrCycle is returned by a guarded traversal when the chain from a list's
+// Head is longer than its size, which means the chain loops or the size is
+// wrong
+var ErrCycle = errors.New("linkedlist: chain does not end within the list size")
+
+// ErrOutOfOrder is returned when a node would break the order of a sorted
+// list
+var ErrOutOfOrder = errors.New("linkedlist: node is out of order")
+
+// ErrDuplicate is returned when a sorted list that rejects duplicates is
+// given a node equal to one it holds
+var ErrDuplicate = errors.New("linkedlist: sorted list already holds an equal node")
+
+// ErrSharedList is returned when nodes are moved between two copies of the
+// same list value, which share their nodes
+var ErrSharedList = errors.New("linkedlist: lists are copies sharing the same nodes")
+
+// ErrNoComparator is the panic value used when nodes are added to a sorted
+// list that was not created by NewSortedOf
+var ErrNoComparator = errors.New("linkedlist: sorted list has no comparator, create it with NewSortedOf")
@@ -0,0 +1,17 @@
+package linkedlist
+
+// ForceNext sets a node's next link without any checks, so tests can build
+// corrupted lists
+func ForceNext[T any](node, next *NodeOf[T]) {
+	node.next = next
+}
+
+// OwnerDepth returns the number of forward links between the id stamped on
+// a node and the id of the list that owns it
+func OwnerDepth[T any](node *NodeOf[T]) int {
+	depth := 0
+	for id := node.list; id != nil && id.forward != nil; id = id.forward {
+		depth++
+	}
+	return depth
+}
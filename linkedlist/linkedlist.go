@@ -1,114 +1,231 @@
 package linkedlist
 
-// LinkedList represents a linked list data structure
-type LinkedList struct {
-	Head *Node
-	Tail *Node
-	size int
-}
-
-// Node represents one link in the linked list
-type Node struct {
-	Value    interface{}
-	next     *Node
-	previous *Node
+// LinkedListOf represents a linked list data structure holding values of type T
+type LinkedListOf[T any] struct {
+	Head  *NodeOf[T]
+	Tail  *NodeOf[T]
+	size  int
+	owner *listID
+	// modCount counts structural modifications so iterators can detect them
+	modCount int
+	// free chains nodes released by the value methods for reuse
+	free     *NodeOf[T]
+	freeSize int
+}
+
+// NodeOf represents one link in a linked list holding values of type T
+type NodeOf[T any] struct {
+	Value    T
+	next     *NodeOf[T]
+	previous *NodeOf[T]
+	list     *listID
+	// pooled marks nodes created by the value methods, which are the only
+	// nodes they recycle
+	pooled bool
+}
+
+// listID identifies the list that owns a node. It is created lazily so
+// that the zero value of a list is ready to use, and it is shared by copies
+// of the list value since they share the same nodes.
+type listID struct {
+	// forward is set when all of a list's nodes were handed to another list
+	// at once, so that nodes stamped with this id belong to that list now
+	forward *listID
+	// rank bounds the length of the forward chains that end at this id
+	rank int
+}
+
+// resolve returns the id of the list that owns the nodes stamped with id.
+// The chain is never shortened here, so that reading ownership does not
+// write to the nodes or ids.
+func (id *listID) resolve() *listID {
+	for id.forward != nil {
+		id = id.forward
+	}
+	return id
+}
+
+// union joins the ids of two lists whose nodes now form one list and
+// returns the id that identifies it. The id with the shorter chains is
+// forwarded to the other, which keeps every chain O(log n) long.
+func union(a, b *listID) *listID {
+	if a.rank < b.rank {
+		a, b = b, a
+	}
+	if a.rank == b.rank {
+		a.rank++
+	}
+	b.forward = a
+	return a
 }
 
 // Next returns the next node, if it exists
-func (n *Node) Next() *Node {
+func (n *NodeOf[T]) Next() *NodeOf[T] {
 	return n.next
 }
 
 // Previous returns the previous node, if it exists
-func (n *Node) Previous() *Node {
+func (n *NodeOf[T]) Previous() *NodeOf[T] {
 	return n.previous
 }
 
-// LinkNext adds the specified node as the next node in the list
-func (n *Node) LinkNext(node *Node) {
+// LinkNext adds the specified node as the next node in the list.
+// It panics with ErrNodeOwned if either node belongs to a list.
+func (n *NodeOf[T]) LinkNext(node *NodeOf[T]) {
+	n.mustBeDetached()
+	node.mustBeDetached()
 	n.next = node
 }
 
-// LinkPrevious adds the specified node as the previous node in the list
-func (n *Node) LinkPrevious(node *Node) {
+// LinkPrevious adds the specified node as the previous node in the list.
+// It panics with ErrNodeOwned if either node belongs to a list.
+func (n *NodeOf[T]) LinkPrevious(node *NodeOf[T]) {
+	n.mustBeDetached()
+	node.mustBeDetached()
 	n.previous = node
 }
 
-// UnlinkNext removes the node linked as "next"
-func (n *Node) UnlinkNext() {
+// UnlinkNext removes the node linked as "next".
+// It panics with ErrNodeOwned if the node belongs to a list.
+func (n *NodeOf[T]) UnlinkNext() {
+	n.mustBeDetached()
 	n.next = nil
 }
 
-// UnlinkPrevious removes the node linked as "previous"
-func (n *Node) UnlinkPrevious() {
+// UnlinkPrevious removes the node linked as "previous".
+// It panics with ErrNodeOwned if the node belongs to a list.
+func (n *NodeOf[T]) UnlinkPrevious() {
+	n.mustBeDetached()
 	n.previous = nil
 }
 
-// New returns an empty linked list
-func New() LinkedList {
-	return LinkedList{}
+// IsDetached indicates if the node does not belong to any list
+func (n *NodeOf[T]) IsDetached() bool {
+	return n.list == nil
 }
 
-// Add appends a node to the end of the list
-func (l *LinkedList) Add(node *Node) {
-	l.addToTail(node)
-	l.size++
+func (n *NodeOf[T]) mustBeDetached() {
+	if n != nil && n.list != nil {
+		panic(ErrNodeOwned)
+	}
 }
 
-// AddToStart adds a node to the beginning of the list
-func (l *LinkedList) AddToStart(node *Node) {
-	if l.Head == nil {
-		l.Head = node
-		l.Tail = node
+// NewOf returns an empty linked list holding values of type T
+func NewOf[T any]() LinkedListOf[T] {
+	return LinkedListOf[T]{}
+}
+
+// Add appends a node to the end of the list.
+// It panics with ErrNodeOwned if the node already belongs to a list.
+func (l *LinkedListOf[T]) Add(node *NodeOf[T]) {
+	l.linkAfter(node, l.Tail)
+}
+
+// AddToStart adds a node to the beginning of the list.
+// It panics with ErrNodeOwned if the node already belongs to a list.
+func (l *LinkedListOf[T]) AddToStart(node *NodeOf[T]) {
+	l.linkBefore(node, l.Head)
+}
+
+func (l *LinkedListOf[T]) id() *listID {
+	if l.owner == nil {
+		l.owner = &listID{}
+	}
+	return l.owner
+}
+
+// Owns indicates if the node is currently linked into this list
+func (l *LinkedListOf[T]) Owns(node *NodeOf[T]) bool {
+	if node == nil || node.list == nil || l.owner == nil {
+		return false
+	}
+	return node.list.resolve() == l.owner
+}
+
+// linkAfter links a detached node after mark. A nil mark links the node at
+// the start of the list.
+func (l *LinkedListOf[T]) linkAfter(node, mark *NodeOf[T]) {
+	node.mustBeDetached()
+	var next *NodeOf[T]
+	if mark == nil {
+		next = l.Head
+	} else {
+		next = mark.next
 	}
-	head := l.Head
-	if head != nil {
-		tmp := l.Head
-		tmp.LinkPrevious(node)
-		node.LinkNext(tmp)
+	node.previous = mark
+	node.next = next
+	node.list = l.id()
+	if mark == nil {
 		l.Head = node
-		l.size++
+	} else {
+		mark.next = node
+	}
+	if next == nil {
+		l.Tail = node
+	} else {
+		next.previous = node
 	}
+	l.size++
+	l.modCount++
+	l.verify()
 }
 
-func (l *LinkedList) iterate(currNode *Node) *Node {
-	for currNode.Next() != nil {
-		currNode = currNode.Next()
+// linkBefore links a detached node before mark. A nil mark links the node at
+// the end of the list.
+func (l *LinkedListOf[T]) linkBefore(node, mark *NodeOf[T]) {
+	if mark == nil {
+		l.linkAfter(node, l.Tail)
+		return
 	}
-	return currNode
+	l.linkAfter(node, mark.previous)
 }
 
-func (l *LinkedList) addToTail(node *Node) {
-	if l.Head == nil {
-		l.Head = node
+// unlink removes a node owned by this list and clears its links
+func (l *LinkedListOf[T]) unlink(node *NodeOf[T]) {
+	if node.previous == nil {
+		l.Head = node.next
 	} else {
-		currTail := l.iterate(l.Head)
-		currTail.LinkNext(node)
-		node.LinkPrevious(currTail)
+		node.previous.next = node.next
 	}
-	l.Tail = node
+	if node.next == nil {
+		l.Tail = node.previous
+	} else {
+		node.next.previous = node.previous
+	}
+	node.next = nil
+	node.previous = nil
+	node.list = nil
+	l.size--
+	l.modCount++
+	l.verify()
 }
 
 // Iterator returns an iterator instance for iterating through the list
-func (l *LinkedList) Iterator() Iterator {
-	return Iterator{list: l}
+func (l *LinkedListOf[T]) Iterator() IteratorOf[T] {
+	return IteratorOf[T]{list: l, expectedModCount: l.modCount}
 }
 
 // DescendingIterator returns a descending iterator
-func (l *LinkedList) DescendingIterator() Iterator {
-	return Iterator{list: l, descending: true, currIndex: l.Size() - 1}
+func (l *LinkedListOf[T]) DescendingIterator() IteratorOf[T] {
+	return IteratorOf[T]{list: l, descending: true, currIndex: l.Size() - 1, expectedModCount: l.modCount}
 }
 
-// Iterator represents the iterator for the list
-type Iterator struct {
-	currIndex  int
-	currNode   *Node
-	list       *LinkedList
-	descending bool
+// IteratorOf represents the iterator for a list holding values of type T
+type IteratorOf[T any] struct {
+	currIndex        int
+	currNode         *NodeOf[T]
+	list             *LinkedListOf[T]
+	descending       bool
+	expectedModCount int
+	err              error
 }
 
-// HasNext indicates if a node exists after the node it calls from
-func (i *Iterator) HasNext() bool {
+// HasNext indicates if a node exists after the node it calls from.
+// It returns false once the list has been modified outside the iterator.
+func (i *IteratorOf[T]) HasNext() bool {
+	if i.modified() {
+		return false
+	}
 	if i.descending {
 		return i.hasPrevious()
 	}
@@ -116,23 +233,48 @@ func (i *Iterator) HasNext() bool {
 }
 
 // HasPrevious indicates if a node exists before the node it calls from
-func (i *Iterator) HasPrevious() bool {
+func (i *IteratorOf[T]) HasPrevious() bool {
+	if i.modified() {
+		return false
+	}
 	if i.descending {
 		return i.hasNext()
 	}
 	return i.hasPrevious()
 }
 
-func (i *Iterator) hasNext() bool {
+func (i *IteratorOf[T]) hasNext() bool {
 	return i.list.Head != nil && i.list.Size() > i.currIndex
 }
 
-func (i *Iterator) hasPrevious() bool {
+func (i *IteratorOf[T]) hasPrevious() bool {
 	return i.list.Head != nil && i.currIndex > -1
 }
 
-// Next returns the next node in the list
-func (i *Iterator) Next() *Node {
+// Err returns ErrConcurrentModification if the list was modified while
+// iterating, otherwise nil
+func (i *IteratorOf[T]) Err() error {
+	return i.err
+}
+
+// modified records ErrConcurrentModification if the list changed since the
+// iterator was created. Built with the linkedlistdebug tag it panics instead.
+func (i *IteratorOf[T]) modified() bool {
+	if i.err == nil && i.list.modCount != i.expectedModCount {
+		i.err = ErrConcurrentModification
+		if debug {
+			panic(i.err)
+		}
+	}
+	return i.err != nil
+}
+
+// Next returns the next node in the list, or nil if the list was modified
+// outside the iterator
+func (i *IteratorOf[T]) Next() *NodeOf[T] {
+	if i.modified() {
+		return nil
+	}
 	if i.descending {
 		if i.currIndex == (i.list.Size() - 1) {
 			i.currNode = i.list.Tail
@@ -155,126 +297,107 @@ func (i *Iterator) Next() *Node {
 }
 
 // RemoveHead removes the first node from the list
-func (l *LinkedList) RemoveHead() *Node {
-	if l.Head != nil {
-		removed := l.Head
-		if l.Head.Next() != nil {
-			l.Head = l.Head.Next()
-		} else {
-			l.Head = nil
-		}
-		l.size--
-		if l.size == 0 {
-			l.Head = nil
-			l.Tail = nil
-		}
-		return removed
+func (l *LinkedListOf[T]) RemoveHead() *NodeOf[T] {
+	removed := l.Head
+	if removed != nil {
+		l.unlink(removed)
 	}
-	return nil
+	return removed
 }
 
 // RemoveTail removes the last node from the list
-func (l *LinkedList) RemoveTail() *Node {
-	if l.Head == nil {
-		return nil
+func (l *LinkedListOf[T]) RemoveTail() *NodeOf[T] {
+	removed := l.Tail
+	if removed != nil {
+		l.unlink(removed)
 	}
-	var prev *Node
-	node := l.Head
-	for node.Next() != nil {
-		prev = node
-		node = node.Next()
+	return removed
+}
+
+// Remove removes the node from the list in constant time
+func (l *LinkedListOf[T]) Remove(node *NodeOf[T]) error {
+	if !l.Owns(node) {
+		return ErrNodeNotInList
 	}
-	var removed *Node
-	if l.Tail != nil {
-		removed = l.Tail
+	l.unlink(node)
+	return nil
+}
+
+// MoveToFront moves the node to the beginning of the list
+func (l *LinkedListOf[T]) MoveToFront(node *NodeOf[T]) error {
+	if !l.Owns(node) {
+		return ErrNodeNotInList
 	}
-	if prev != nil {
-		prev.UnlinkNext()
-		l.Tail = prev
-		l.size--
-	} else {
-		l.Head = nil
-		l.Tail = nil
-		l.size--
+	if node != l.Head {
+		l.unlink(node)
+		l.linkAfter(node, nil)
 	}
-	return removed
+	return nil
+}
+
+// MoveToBack moves the node to the end of the list
+func (l *LinkedListOf[T]) MoveToBack(node *NodeOf[T]) error {
+	if !l.Owns(node) {
+		return ErrNodeNotInList
+	}
+	if node != l.Tail {
+		l.unlink(node)
+		l.linkBefore(node, nil)
+	}
+	return nil
 }
 
-// MatcherFn represents a matcher
-type MatcherFn = func(*Node) bool
+// MoveBefore moves the node so that it comes directly before mark
+func (l *LinkedListOf[T]) MoveBefore(node, mark *NodeOf[T]) error {
+	if !l.Owns(node) || !l.Owns(mark) {
+		return ErrNodeNotInList
+	}
+	if node != mark && node.next != mark {
+		l.unlink(node)
+		l.linkBefore(node, mark)
+	}
+	return nil
+}
 
-// RemoveFirstOccurrence removes the first occurence of the value in the list
-func (l *LinkedList) RemoveFirstOccurrence(matcher MatcherFn) bool {
-	// if the value removed is the last.. it won't have next
-	if l.Head == nil {
-		return false
+// MoveAfter moves the node so that it comes directly after mark
+func (l *LinkedListOf[T]) MoveAfter(node, mark *NodeOf[T]) error {
+	if !l.Owns(node) || !l.Owns(mark) {
+		return ErrNodeNotInList
 	}
-	var prev *Node
-	node := l.Head
-	for node.Next() != nil {
+	if node != mark && node.previous != mark {
+		l.unlink(node)
+		l.linkAfter(node, mark)
+	}
+	return nil
+}
+
+// MatcherFnOf represents a matcher for nodes holding values of type T
+type MatcherFnOf[T any] func(*NodeOf[T]) bool
+
+// RemoveFirstOccurrence removes the first occurence of the value in the list
+func (l *LinkedListOf[T]) RemoveFirstOccurrence(matcher MatcherFnOf[T]) bool {
+	for node := l.Head; node != nil; node = node.next {
 		if matcher(node) {
-			if prev == nil {
-				// replace head with next node
-				l.Head = node.Next()
-			} else {
-				// replace prev-next with next
-				prev.LinkNext(node.Next())
-				node.Next().LinkPrevious(prev)
-			}
-			l.size--
+			l.unlink(node)
 			return true
 		}
-		prev = node
-		node = node.Next()
-	}
-	if matcher(node) {
-		prev.UnlinkNext()
-		l.size--
-		l.Tail = prev
-		return true
 	}
 	return false
 }
 
 // RemoveLastOccurrence removes the last occurence of the node in the list
-func (l *LinkedList) RemoveLastOccurrence(matcher MatcherFn) bool {
-	if l.Head == nil {
-		return false
-	}
-	node := l.Tail
-	var prev *Node
-	i := l.Size() - 1
-	for node.Previous() != nil {
+func (l *LinkedListOf[T]) RemoveLastOccurrence(matcher MatcherFnOf[T]) bool {
+	for node := l.Tail; node != nil; node = node.previous {
 		if matcher(node) {
-			if i == (l.Size() - 1) {
-				// tail
-				if node.Previous() != nil {
-					node.Previous().UnlinkNext()
-				}
-				l.Tail = node.Previous()
-			} else if i > 0 {
-				// middle
-				node.Previous().LinkNext(prev)
-				node.Next().LinkPrevious(node.Previous())
-			}
-			l.size--
+			l.unlink(node)
 			return true
 		}
-		prev = node
-		node = node.Previous()
-		i--
-	}
-	if matcher(node) {
-		// head
-		l.Head = node.Next()
-		l.size--
-		return true
 	}
 	return false
 }
 
 // Find finds the first occurence of the value and returns the node
-func (l *LinkedList) Find(matcher func(node *Node) bool) (bool, *Node) {
+func (l *LinkedListOf[T]) Find(matcher MatcherFnOf[T]) (bool, *NodeOf[T]) {
 	if l.Head == nil {
 		return false, nil
 	}
@@ -291,133 +414,141 @@ func (l *LinkedList) Find(matcher func(node *Node) bool) (bool, *Node) {
 	return false, nil
 }
 
-// InsertBefore inserts a new node before the matched node
-func (l *LinkedList) InsertBefore(matcher MatcherFn, new *Node) {
-	if l.Head == nil {
-		return
+// InsertBefore inserts a new node before the matched node.
+// It panics with ErrNodeOwned if the new node already belongs to a list.
+func (l *LinkedListOf[T]) InsertBefore(matcher MatcherFnOf[T], new *NodeOf[T]) {
+	if found, node := l.Find(matcher); found {
+		l.linkBefore(new, node)
 	}
-	if matcher(l.Head) {
-		l.AddToStart(new)
-		return
+}
+
+// InsertAfter inserts a new node after the matched node.
+// It panics with ErrNodeOwned if the new node already belongs to a list.
+func (l *LinkedListOf[T]) InsertAfter(matcher MatcherFnOf[T], new *NodeOf[T]) {
+	if found, node := l.Find(matcher); found {
+		l.linkAfter(new, node)
 	}
-	var prev *Node
-	node := l.Head
-	for node.Next() != nil {
-		if matcher(node) {
-			if prev != nil {
-				prev.LinkNext(new)
-				new.LinkPrevious(prev)
-				new.LinkNext(node)
-				node.LinkPrevious(new)
-			}
-			l.size++
-			return
-		}
-		prev = node
-		node = node.Next()
+}
+
+// AddAll appends items to the end of the list.
+// It panics with ErrNodeOwned if any of the nodes already belong to a list.
+func (l *LinkedListOf[T]) AddAll(all []*NodeOf[T]) {
+	for i := 0; i < len(all); i++ {
+		l.Add(all[i])
 	}
-	if matcher(node) {
-		if prev != nil {
-			prev.LinkNext(new)
-			new.LinkPrevious(prev)
-			new.LinkNext(node)
-			node.LinkPrevious(new)
-		}
-		l.size++
-		return
+}
+
+// Clear removes all items from the list
+func (l *LinkedListOf[T]) Clear() {
+	for l.Head != nil {
+		l.unlink(l.Head)
 	}
 }
 
-// InsertAfter inserts a new node before the matched node
-func (l *LinkedList) InsertAfter(matcher MatcherFn, new *Node) {
-	if l.Head == nil {
-		return
+// Get returns the node at the specified index
+func (l *LinkedListOf[T]) Get(index int) (bool, *NodeOf[T]) {
+	if index < 0 || index >= l.size {
+		return false, nil
 	}
-	if matcher(l.Head) {
-		if l.Head.Next() != nil {
-			// head matches and is already linked to next node
-			// cache current next node
-			tmp := l.Head.Next()
-			// link new node as next
-			l.Head.LinkNext(new)
-			new.LinkPrevious(l.Head)
-
-			new.LinkNext(tmp)
-			tmp.LinkPrevious(new)
-		} else {
-			// head matches and is not linked to a next node
-			l.Head.LinkNext(new)
-			new.LinkPrevious(l.Head)
-			l.Tail = new
+	return true, l.nodeAt(index)
+}
+
+// nodeAt walks to the node at a valid index from whichever end is closer
+func (l *LinkedListOf[T]) nodeAt(index int) *NodeOf[T] {
+	if index < l.size/2 {
+		node := l.Head
+		for i := 0; i < index; i++ {
+			node = node.next
 		}
-		l.size++
-		return
+		return node
 	}
-	node := l.Head.Next()
-	for node.Next() != nil {
-		if matcher(node) {
-			tmp := node.Next()
-			node.LinkNext(new)
-			new.LinkPrevious(node)
-
-			node.Next().LinkNext(tmp)
-			tmp.LinkPrevious(node.Next())
-			l.size++
-			return
-		}
-		node = node.Next()
+	node := l.Tail
+	for i := l.size - 1; i > index; i-- {
+		node = node.previous
 	}
-	if matcher(node) {
-		node.LinkNext(new)
-		new.LinkPrevious(node)
-		l.Tail = node.Next()
-		l.size++
-		return
+	return node
+}
+
+// InsertAt inserts a node at the specified index, shifting the node
+// currently at that index and any after it towards the end of the list.
+// An index equal to Size appends the node.
+// It panics with ErrNodeOwned if the node already belongs to a list.
+func (l *LinkedListOf[T]) InsertAt(index int, node *NodeOf[T]) error {
+	node.mustBeDetached()
+	if index < 0 || index > l.size {
+		return ErrIndexOutOfRange
 	}
+	if index == l.size {
+		l.linkAfter(node, l.Tail)
+	} else {
+		l.linkBefore(node, l.nodeAt(index))
+	}
+	return nil
 }
 
-// AddAll appends items to the end of the list
-func (l *LinkedList) AddAll(all []*Node) {
-	for i := 0; i < len(all); i++ {
-		l.Add(all[i])
+// RemoveAt removes and returns the node at the specified index
+func (l *LinkedListOf[T]) RemoveAt(index int) (*NodeOf[T], error) {
+	if index < 0 || index >= l.size {
+		return nil, ErrIndexOutOfRange
 	}
+	node := l.nodeAt(index)
+	l.unlink(node)
+	return node, nil
 }
 
-// Clear removes all items from the list
-func (l *LinkedList) Clear() {
-	l.Head = nil
-	l.Tail = nil
-	l.size = 0
+// Swap exchanges the positions of the nodes at indexes i and j
+func (l *LinkedListOf[T]) Swap(i, j int) error {
+	if i < 0 || i >= l.size || j < 0 || j >= l.size {
+		return ErrIndexOutOfRange
+	}
+	if i == j {
+		return nil
+	}
+	if i > j {
+		i, j = j, i
+	}
+	a := l.nodeAt(i)
+	b := l.nodeAt(j)
+	if a.next == b {
+		l.unlink(a)
+		l.linkAfter(a, b)
+		return nil
+	}
+	aPrev := a.previous
+	bPrev := b.previous
+	l.unlink(a)
+	l.unlink(b)
+	l.linkAfter(b, aPrev)
+	l.linkAfter(a, bPrev)
+	return nil
 }
 
-// Get returns the node at the specified index
-func (l *LinkedList) Get(index int) (bool, *Node) {
-	if l.Head == nil {
-		return false, nil
+// RemoveRange removes the nodes from index from (inclusive) to index to
+// (exclusive)
+func (l *LinkedListOf[T]) RemoveRange(from, to int) error {
+	if from < 0 || to > l.size || from > to {
+		return ErrIndexOutOfRange
 	}
-	i := 0
-	node := l.Head
-	for node.Next() != nil {
-		if i == index {
-			return true, node
-		}
-		node = node.Next()
-		i++
+	if from == to {
+		return nil
 	}
-	if i == index {
-		return true, node
+	node := l.nodeAt(from)
+	for i := from; i < to; i++ {
+		next := node.next
+		l.unlink(node)
+		node = next
 	}
-	return false, nil
+	return nil
 }
 
 // Size returns the total number of nodes in the list
-func (l *LinkedList) Size() int {
+func (l *LinkedListOf[T]) Size() int {
 	return l.size
 }
 
 // ToSlice returns a slice of the nodes in this list
-func (l *LinkedList) ToSlice() []*Node {
-	slice := make([]*Node, l.Size())
+func (l *LinkedListOf[T]) ToSlice() []*NodeOf[T] {
+	slice := make([]*NodeOf[T], l.Size())
 	it := l.Iterator()
 	i := 0
 	for it.HasNext() {
@@ -427,50 +558,22 @@ func (l *LinkedList) ToSlice() []*Node {
 	return slice
 }
 
-// Set replaces the node at the specified index
-func (l *LinkedList) Set(index int, new *Node) bool {
-	if l.Head == nil {
+// Set replaces the node at the specified index.
+// It panics with ErrNodeOwned if the new node already belongs to a list.
+func (l *LinkedListOf[T]) Set(index int, new *NodeOf[T]) bool {
+	new.mustBeDetached()
+	found, node := l.Get(index)
+	if !found {
 		return false
 	}
-	if index == 0 {
-		if l.Head == l.Tail {
-			l.Head = new
-			l.Tail = new
-			return true
-		}
-		new.LinkNext(l.Head.Next())
-		l.Head.Next().LinkPrevious(new)
-		l.Head = new
-		return true
-	}
-	node := l.Head
-	i := 0
-	for node.Next() != nil {
-		if index == i {
-			if node.Previous() != nil {
-				new.LinkPrevious(node.Previous())
-				node.Previous().LinkNext(new)
-			}
-			new.LinkNext(node.Next())
-			node.Next().LinkPrevious(new)
-			return true
-		}
-		i++
-		node = node.Next()
-	}
-	if index == i {
-		if node.Previous() != nil {
-			new.LinkPrevious(node.Previous())
-			node.Previous().LinkNext(new)
-		}
-		l.Tail = new
-		return true
-	}
-	return false
+	mark := node.previous
+	l.unlink(node)
+	l.linkAfter(new, mark)
+	return true
 }
 
 // IndexOf returns the first index of the node in the list
-func (l *LinkedList) IndexOf(matcher func(node *Node) bool) int {
+func (l *LinkedListOf[T]) IndexOf(matcher MatcherFnOf[T]) int {
 	if l.Head == nil {
 		return -1
 	}
@@ -490,7 +593,7 @@ func (l *LinkedList) IndexOf(matcher func(node *Node) bool) int {
 }
 
 // LastIndexOf returns the last index of the value in the list
-func (l *LinkedList) LastIndexOf(matcher func(node *Node) bool) int {
+func (l *LinkedListOf[T]) LastIndexOf(matcher MatcherFnOf[T]) int {
 	if l.Head == nil {
 		return -1
 	}
@@ -514,8 +617,8 @@ func (l *LinkedList) LastIndexOf(matcher func(node *Node) bool) int {
 }
 
 // Copy returns a copy of this linked list
-func (l *LinkedList) Copy(copy func(node *Node) *Node) LinkedList {
-	new := New()
+func (l *LinkedListOf[T]) Copy(copy func(node *NodeOf[T]) *NodeOf[T]) LinkedListOf[T] {
+	new := NewOf[T]()
 	it := l.Iterator()
 	for it.HasNext() {
 		node := it.Next()
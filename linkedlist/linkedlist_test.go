@@ -100,6 +100,46 @@ func TestDescendingIterator(t *testing.T) {
 	})
 }
 
+func TestIteratorConcurrentModification(t *testing.T) {
+	modifications := map[string]func(list *linkedlist.LinkedList){
+		"add":         func(list *linkedlist.LinkedList) { list.Add(&linkedlist.Node{Value: 4}) },
+		"remove head": func(list *linkedlist.LinkedList) { list.RemoveHead() },
+		"clear":       func(list *linkedlist.LinkedList) { list.Clear() },
+	}
+	for name, modify := range modifications {
+		t.Run(name, func(t *testing.T) {
+			list := newList(1, 2, 3)
+			it := list.Iterator()
+			assertDetectsModification(t, func() error {
+				it.Next()
+				modify(&list)
+				for it.HasNext() {
+					it.Next()
+				}
+				return it.Err()
+			})
+		})
+		t.Run(name+" descending", func(t *testing.T) {
+			list := newList(1, 2, 3)
+			it := list.DescendingIterator()
+			assertDetectsModification(t, func() error {
+				it.Next()
+				modify(&list)
+				ok(t, it.Next() == nil)
+				return it.Err()
+			})
+		})
+	}
+	t.Run("unmodified", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		it := list.Iterator()
+		for it.HasNext() {
+			it.Next()
+		}
+		ok(t, it.Err() == nil)
+	})
+}
+
 func TestRemoveHead(t *testing.T) {
 	t.Run("foo", func(t *testing.T) {
 		list := newList()
@@ -195,6 +235,232 @@ func TestFind(t *testing.T) {
 	ok(t, node == nil)
 }
 
+func TestRemoveKeepsLinksConsistent(t *testing.T) {
+	t.Run("remove head", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		node := list.RemoveHead()
+		ok(t, node.Next() == nil)
+		ok(t, list.Head.Previous() == nil)
+		assertListNodes(t, list, 2, 3)
+	})
+	t.Run("remove tail", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		node := list.RemoveTail()
+		ok(t, node.Previous() == nil)
+		ok(t, list.Tail.Next() == nil)
+		assertListNodes(t, list, 1, 2)
+	})
+	t.Run("remove first occurrence of only node", func(t *testing.T) {
+		list := newList(1)
+		ok(t, list.RemoveFirstOccurrence(buildMatcherFn(1)))
+		assertListIsEmpty(t, list)
+		list.Add(&linkedlist.Node{Value: 2})
+		assertListNodes(t, list, 2)
+	})
+	t.Run("remove last occurrence of only node", func(t *testing.T) {
+		list := newList(1)
+		ok(t, list.RemoveLastOccurrence(buildMatcherFn(1)))
+		assertListIsEmpty(t, list)
+		list.Add(&linkedlist.Node{Value: 2})
+		assertListNodes(t, list, 2)
+	})
+	t.Run("remove first occurrence at head", func(t *testing.T) {
+		list := newList(1, 2)
+		ok(t, list.RemoveFirstOccurrence(buildMatcherFn(1)))
+		ok(t, list.Head.Previous() == nil)
+		assertListNodes(t, list, 2)
+	})
+}
+
+func TestRemove(t *testing.T) {
+	t.Run("head", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		nodes := list.ToSlice()
+		ok(t, list.Remove(nodes[0]) == nil)
+		assertListNodes(t, list, 2, 3)
+	})
+	t.Run("middle", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		nodes := list.ToSlice()
+		ok(t, list.Remove(nodes[1]) == nil)
+		ok(t, nodes[1].Next() == nil && nodes[1].Previous() == nil)
+		assertListNodes(t, list, 1, 3)
+	})
+	t.Run("tail", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		nodes := list.ToSlice()
+		ok(t, list.Remove(nodes[2]) == nil)
+		assertListNodes(t, list, 1, 2)
+	})
+	t.Run("only node", func(t *testing.T) {
+		list := newList(1)
+		ok(t, list.Remove(list.Head) == nil)
+		assertListIsEmpty(t, list)
+	})
+	t.Run("twice", func(t *testing.T) {
+		list := newList(1, 2)
+		node := list.Head
+		ok(t, list.Remove(node) == nil)
+		ok(t, list.Remove(node) == linkedlist.ErrNodeNotInList)
+		assertListNodes(t, list, 2)
+	})
+	t.Run("node from another list", func(t *testing.T) {
+		a := newList(1, 2)
+		b := newList(3, 4)
+		ok(t, a.Remove(b.Head) == linkedlist.ErrNodeNotInList)
+		ok(t, a.Remove(&linkedlist.Node{Value: 1}) == linkedlist.ErrNodeNotInList)
+		ok(t, a.Remove(nil) == linkedlist.ErrNodeNotInList)
+		assertListNodes(t, a, 1, 2)
+		assertListNodes(t, b, 3, 4)
+	})
+}
+
+func TestMoveToFront(t *testing.T) {
+	list := newList(1, 2, 3)
+	nodes := list.ToSlice()
+
+	ok(t, list.MoveToFront(nodes[2]) == nil)
+	assertListNodes(t, list, 3, 1, 2)
+
+	ok(t, list.MoveToFront(nodes[1]) == nil)
+	assertListNodes(t, list, 2, 3, 1)
+
+	ok(t, list.MoveToFront(nodes[1]) == nil)
+	assertListNodes(t, list, 2, 3, 1)
+
+	other := newList(4)
+	ok(t, list.MoveToFront(other.Head) == linkedlist.ErrNodeNotInList)
+	assertListNodes(t, list, 2, 3, 1)
+	assertListNodes(t, other, 4)
+}
+
+func TestMoveToBack(t *testing.T) {
+	list := newList(1, 2, 3)
+	nodes := list.ToSlice()
+
+	ok(t, list.MoveToBack(nodes[0]) == nil)
+	assertListNodes(t, list, 2, 3, 1)
+
+	ok(t, list.MoveToBack(nodes[2]) == nil)
+	assertListNodes(t, list, 2, 1, 3)
+
+	ok(t, list.MoveToBack(nodes[2]) == nil)
+	assertListNodes(t, list, 2, 1, 3)
+
+	other := newList(4)
+	ok(t, list.MoveToBack(other.Head) == linkedlist.ErrNodeNotInList)
+	assertListNodes(t, list, 2, 1, 3)
+}
+
+func TestMoveBefore(t *testing.T) {
+	list := newList(1, 2, 3, 4)
+	nodes := list.ToSlice()
+
+	ok(t, list.MoveBefore(nodes[3], nodes[0]) == nil)
+	assertListNodes(t, list, 4, 1, 2, 3)
+
+	ok(t, list.MoveBefore(nodes[3], nodes[2]) == nil)
+	assertListNodes(t, list, 1, 2, 4, 3)
+
+	ok(t, list.MoveBefore(nodes[1], nodes[1]) == nil)
+	assertListNodes(t, list, 1, 2, 4, 3)
+
+	ok(t, list.MoveBefore(nodes[1], nodes[3]) == nil)
+	assertListNodes(t, list, 1, 2, 4, 3)
+
+	other := newList(5)
+	ok(t, list.MoveBefore(other.Head, nodes[0]) == linkedlist.ErrNodeNotInList)
+	ok(t, list.MoveBefore(nodes[0], other.Head) == linkedlist.ErrNodeNotInList)
+	assertListNodes(t, list, 1, 2, 4, 3)
+	assertListNodes(t, other, 5)
+}
+
+func TestMoveAfter(t *testing.T) {
+	list := newList(1, 2, 3, 4)
+	nodes := list.ToSlice()
+
+	ok(t, list.MoveAfter(nodes[0], nodes[3]) == nil)
+	assertListNodes(t, list, 2, 3, 4, 1)
+
+	ok(t, list.MoveAfter(nodes[3], nodes[0]) == nil)
+	assertListNodes(t, list, 2, 3, 1, 4)
+
+	ok(t, list.MoveAfter(nodes[2], nodes[1]) == nil)
+	assertListNodes(t, list, 2, 3, 1, 4)
+
+	other := newList(5)
+	ok(t, list.MoveAfter(other.Head, nodes[0]) == linkedlist.ErrNodeNotInList)
+	ok(t, list.MoveAfter(nodes[0], other.Head) == linkedlist.ErrNodeNotInList)
+	assertListNodes(t, list, 2, 3, 1, 4)
+}
+
+func TestOwnership(t *testing.T) {
+	t.Run("add same node twice", func(t *testing.T) {
+		list := newList(1)
+		node := &linkedlist.Node{Value: 2}
+		list.Add(node)
+		assertPanics(t, linkedlist.ErrNodeOwned, func() { list.Add(node) })
+		assertPanics(t, linkedlist.ErrNodeOwned, func() { list.AddToStart(node) })
+		assertListNodes(t, list, 1, 2)
+	})
+	t.Run("add node owned by another list", func(t *testing.T) {
+		a := newList(1, 2)
+		b := newList(3)
+		assertPanics(t, linkedlist.ErrNodeOwned, func() { b.Add(a.Head) })
+		assertPanics(t, linkedlist.ErrNodeOwned, func() {
+			b.InsertBefore(buildMatcherFn(3), a.Head)
+		})
+		assertPanics(t, linkedlist.ErrNodeOwned, func() {
+			b.InsertAfter(buildMatcherFn(3), a.Head)
+		})
+		assertPanics(t, linkedlist.ErrNodeOwned, func() { b.Set(0, a.Tail) })
+		assertListNodes(t, a, 1, 2)
+		assertListNodes(t, b, 3)
+	})
+	t.Run("move node between lists", func(t *testing.T) {
+		a := newList(1, 2)
+		b := newList(3)
+		node := a.Head
+		ok(t, a.Owns(node))
+		ok(t, !b.Owns(node))
+		ok(t, a.Remove(node) == nil)
+		ok(t, node.IsDetached())
+		b.Add(node)
+		ok(t, b.Owns(node))
+		assertListNodes(t, a, 2)
+		assertListNodes(t, b, 3, 1)
+	})
+	t.Run("replaced and cleared nodes are detached", func(t *testing.T) {
+		list := newList(1, 2)
+		replaced := list.Head
+		ok(t, list.Set(0, &linkedlist.Node{Value: 3}))
+		ok(t, replaced.IsDetached())
+		tail := list.Tail
+		list.Clear()
+		ok(t, tail.IsDetached())
+		ok(t, tail.Previous() == nil)
+	})
+	t.Run("raw links on owned nodes", func(t *testing.T) {
+		list := newList(1, 2)
+		detached := &linkedlist.Node{Value: 3}
+		assertPanics(t, linkedlist.ErrNodeOwned, func() { list.Tail.LinkNext(detached) })
+		assertPanics(t, linkedlist.ErrNodeOwned, func() { detached.LinkPrevious(list.Tail) })
+		assertPanics(t, linkedlist.ErrNodeOwned, func() { list.Head.UnlinkNext() })
+		assertPanics(t, linkedlist.ErrNodeOwned, func() { list.Tail.UnlinkPrevious() })
+		assertListNodes(t, list, 1, 2)
+	})
+	t.Run("raw links on detached nodes", func(t *testing.T) {
+		a := &linkedlist.Node{Value: 1}
+		b := &linkedlist.Node{Value: 2}
+		a.LinkNext(b)
+		b.LinkPrevious(a)
+		ok(t, a.Next() == b && b.Previous() == a)
+		a.UnlinkNext()
+		b.UnlinkPrevious()
+		ok(t, a.Next() == nil && b.Previous() == nil)
+	})
+}
+
 func TestRemoveFirstOccurrence(t *testing.T) {
 	list := newList()
 
@@ -408,6 +674,126 @@ func TestGet(t *testing.T) {
 	})
 }
 
+func TestGetFromTail(t *testing.T) {
+	list := newList(1, 2, 3, 4, 5)
+	for i := 0; i < 5; i++ {
+		success, node := list.Get(i)
+		ok(t, success)
+		assertNodeValue(t, i+1, node)
+	}
+	success, node := list.Get(-1)
+	ok(t, !success)
+	ok(t, node == nil)
+}
+
+func TestInsertAt(t *testing.T) {
+	list := newList()
+	ok(t, list.InsertAt(0, &linkedlist.Node{Value: 2}) == nil)
+	assertListNodes(t, list, 2)
+
+	ok(t, list.InsertAt(0, &linkedlist.Node{Value: 1}) == nil)
+	assertListNodes(t, list, 1, 2)
+
+	ok(t, list.InsertAt(2, &linkedlist.Node{Value: 4}) == nil)
+	assertListNodes(t, list, 1, 2, 4)
+
+	ok(t, list.InsertAt(2, &linkedlist.Node{Value: 3}) == nil)
+	assertListNodes(t, list, 1, 2, 3, 4)
+
+	ok(t, list.InsertAt(5, &linkedlist.Node{Value: 5}) == linkedlist.ErrIndexOutOfRange)
+	ok(t, list.InsertAt(-1, &linkedlist.Node{Value: 5}) == linkedlist.ErrIndexOutOfRange)
+	assertPanics(t, linkedlist.ErrNodeOwned, func() { list.InsertAt(0, list.Tail) })
+	assertListNodes(t, list, 1, 2, 3, 4)
+}
+
+func TestRemoveAt(t *testing.T) {
+	list := newList(1, 2, 3, 4, 5)
+
+	node, err := list.RemoveAt(3)
+	ok(t, err == nil)
+	assertNodeValue(t, 4, node)
+	ok(t, node.IsDetached())
+	assertListNodes(t, list, 1, 2, 3, 5)
+
+	node, err = list.RemoveAt(0)
+	ok(t, err == nil)
+	assertNodeValue(t, 1, node)
+	assertListNodes(t, list, 2, 3, 5)
+
+	node, err = list.RemoveAt(2)
+	ok(t, err == nil)
+	assertNodeValue(t, 5, node)
+	assertListNodes(t, list, 2, 3)
+
+	node, err = list.RemoveAt(2)
+	ok(t, err == linkedlist.ErrIndexOutOfRange)
+	ok(t, node == nil)
+
+	node, err = list.RemoveAt(-1)
+	ok(t, err == linkedlist.ErrIndexOutOfRange)
+	ok(t, node == nil)
+	assertListNodes(t, list, 2, 3)
+}
+
+func TestSwap(t *testing.T) {
+	t.Run("head and tail", func(t *testing.T) {
+		list := newList(1, 2, 3, 4)
+		ok(t, list.Swap(0, 3) == nil)
+		assertListNodes(t, list, 4, 2, 3, 1)
+	})
+	t.Run("adjacent", func(t *testing.T) {
+		list := newList(1, 2, 3, 4)
+		ok(t, list.Swap(2, 1) == nil)
+		assertListNodes(t, list, 1, 3, 2, 4)
+	})
+	t.Run("two nodes", func(t *testing.T) {
+		list := newList(1, 2)
+		ok(t, list.Swap(0, 1) == nil)
+		assertListNodes(t, list, 2, 1)
+	})
+	t.Run("same index", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		ok(t, list.Swap(1, 1) == nil)
+		assertListNodes(t, list, 1, 2, 3)
+	})
+	t.Run("out of range", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		ok(t, list.Swap(0, 3) == linkedlist.ErrIndexOutOfRange)
+		ok(t, list.Swap(-1, 0) == linkedlist.ErrIndexOutOfRange)
+		assertListNodes(t, list, 1, 2, 3)
+	})
+}
+
+func TestRemoveRange(t *testing.T) {
+	t.Run("middle", func(t *testing.T) {
+		list := newList(1, 2, 3, 4, 5)
+		ok(t, list.RemoveRange(1, 4) == nil)
+		assertListNodes(t, list, 1, 5)
+	})
+	t.Run("all", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		ok(t, list.RemoveRange(0, 3) == nil)
+		assertListIsEmpty(t, list)
+	})
+	t.Run("tail", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		ok(t, list.RemoveRange(2, 3) == nil)
+		assertListNodes(t, list, 1, 2)
+	})
+	t.Run("empty range", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		ok(t, list.RemoveRange(1, 1) == nil)
+		assertListNodes(t, list, 1, 2, 3)
+	})
+	t.Run("out of range", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		ok(t, list.RemoveRange(2, 4) == linkedlist.ErrIndexOutOfRange)
+		ok(t, list.RemoveRange(-1, 2) == linkedlist.ErrIndexOutOfRange)
+		ok(t, list.RemoveRange(2, 1) == linkedlist.ErrIndexOutOfRange)
+		assertListNodes(t, list, 1, 2, 3)
+	})
+}
+
 func TestToSlice(t *testing.T) {
 	list := newList(1, 2, 3)
 	slice := list.ToSlice()
@@ -595,6 +981,76 @@ func TestCopy(t *testing.T) {
 	assertListNodes(t, b, 1, 2, 3)
 }
 
+func TestTypedList(t *testing.T) {
+	list := linkedlist.NewOf[string]()
+	list.Add(&linkedlist.NodeOf[string]{Value: "b"})
+	list.AddToStart(&linkedlist.NodeOf[string]{Value: "a"})
+	list.Add(&linkedlist.NodeOf[string]{Value: "d"})
+
+	isValue := func(v string) linkedlist.MatcherFnOf[string] {
+		return func(node *linkedlist.NodeOf[string]) bool {
+			return node.Value == v
+		}
+	}
+
+	list.InsertBefore(isValue("d"), &linkedlist.NodeOf[string]{Value: "c"})
+	list.InsertAfter(isValue("d"), &linkedlist.NodeOf[string]{Value: "e"})
+	assertTypedListNodes(t, list, "a", "b", "c", "d", "e")
+
+	success, node := list.Get(2)
+	ok(t, success)
+	ok(t, node.Value == "c")
+
+	ok(t, list.Set(2, &linkedlist.NodeOf[string]{Value: "x"}))
+	assertTypedListNodes(t, list, "a", "b", "x", "d", "e")
+
+	ok(t, list.RemoveFirstOccurrence(isValue("x")))
+	assertTypedListNodes(t, list, "a", "b", "d", "e")
+
+	copied := list.Copy(func(node *linkedlist.NodeOf[string]) *linkedlist.NodeOf[string] {
+		return &linkedlist.NodeOf[string]{Value: node.Value + node.Value}
+	})
+	assertTypedListNodes(t, copied, "aa", "bb", "dd", "ee")
+	assertTypedListNodes(t, list, "a", "b", "d", "e")
+
+	collect := ""
+	it := list.DescendingIterator()
+	for it.HasNext() {
+		collect += it.Next().Value
+	}
+	ok(t, collect == "edba")
+}
+
+func assertTypedListNodes(t *testing.T, list linkedlist.LinkedListOf[string], expected ...string) {
+	slice := list.ToSlice()
+	if len(slice) != len(expected) {
+		t.Fatal("list size is unexpected")
+	}
+	for i, node := range slice {
+		if node.Value != expected[i] {
+			t.Fatal("node value is unexpected - index: ", i, " got: ", node.Value, " expected: ", expected[i])
+		}
+	}
+	if list.Tail.Value != expected[len(expected)-1] {
+		t.Fatal("tail value is unexpected")
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	list := linkedlist.New()
+	for i := 0; i < b.N; i++ {
+		list.Add(&linkedlist.Node{Value: i})
+	}
+}
+
+func BenchmarkRemoveTail(b *testing.B) {
+	list := newList(1, 2, 3)
+	for i := 0; i < b.N; i++ {
+		list.Add(&linkedlist.Node{Value: i})
+		list.RemoveTail()
+	}
+}
+
 // Stolen from https://github.com/stretchr/testify/blob/master/assert/assertions.go#L103
 // CallerInfo returns an array of strings containing the file and line number
 // of each stack frame leading from the current test to the assert call that
@@ -707,6 +1163,32 @@ func assertListNodes(t *testing.T, list linkedlist.LinkedList, expected ...int)
 	}
 }
 
+// assertDetectsModification passes if fn reports ErrConcurrentModification,
+// either as its result or, when built with the linkedlistdebug tag, by panicking
+func assertDetectsModification(t *testing.T, fn func() error) {
+	var err error
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				err, _ = r.(error)
+			}
+		}()
+		err = fn()
+	}()
+	if err != linkedlist.ErrConcurrentModification {
+		t.Fatal("modification was not detected - got: ", err)
+	}
+}
+
+func assertPanics(t *testing.T, expected interface{}, fn func()) {
+	defer func() {
+		if r := recover(); r != expected {
+			t.Fatal("unexpected panic - got: ", r, " expected: ", expected)
+		}
+	}()
+	fn()
+}
+
 func ok(t *testing.T, b bool) {
 	if b == false {
 		t.Fatal("not ok")
@@ -0,0 +1,155 @@
+package linkedlist
+
+// ListIteratorOf iterates in either direction over a list holding values of
+// type T and can modify the list at its cursor. The cursor always sits
+// between two nodes: the one Previous would return and the one Next would.
+type ListIteratorOf[T any] struct {
+	list             *LinkedListOf[T]
+	next             *NodeOf[T]
+	nextIndex        int
+	lastReturned     *NodeOf[T]
+	expectedModCount int
+	err              error
+}
+
+// ListIterator returns a list iterator positioned at the start of the list
+func (l *LinkedListOf[T]) ListIterator() ListIteratorOf[T] {
+	return ListIteratorOf[T]{list: l, next: l.Head, expectedModCount: l.modCount}
+}
+
+// ListIteratorAt returns a list iterator positioned so that the first call to
+// Next returns the node at the specified index. An index equal to Size
+// positions the iterator at the end of the list.
+func (l *LinkedListOf[T]) ListIteratorAt(index int) (ListIteratorOf[T], error) {
+	if index < 0 || index > l.size {
+		return ListIteratorOf[T]{}, ErrIndexOutOfRange
+	}
+	it := ListIteratorOf[T]{list: l, nextIndex: index, expectedModCount: l.modCount}
+	if index < l.size {
+		it.next = l.nodeAt(index)
+	}
+	return it, nil
+}
+
+// HasNext indicates if a node exists after the cursor.
+// It returns false once the list has been modified outside the iterator.
+func (i *ListIteratorOf[T]) HasNext() bool {
+	return !i.modified() && i.nextIndex < i.list.size
+}
+
+// HasPrevious indicates if a node exists before the cursor.
+// It returns false once the list has been modified outside the iterator.
+func (i *ListIteratorOf[T]) HasPrevious() bool {
+	return !i.modified() && i.nextIndex > 0
+}
+
+// Err returns ErrConcurrentModification if the list was modified outside
+// the iterator, otherwise nil
+func (i *ListIteratorOf[T]) Err() error {
+	return i.err
+}
+
+// modified records ErrConcurrentModification if the list changed other than
+// through this iterator. Built with the linkedlistdebug tag it panics instead.
+func (i *ListIteratorOf[T]) modified() bool {
+	if i.err == nil && i.list.modCount != i.expectedModCount {
+		i.err = ErrConcurrentModification
+		if debug {
+			panic(i.err)
+		}
+	}
+	return i.err != nil
+}
+
+// Next moves the cursor forward and returns the node it passed over
+func (i *ListIteratorOf[T]) Next() *NodeOf[T] {
+	if !i.HasNext() {
+		return nil
+	}
+	i.lastReturned = i.next
+	i.next = i.next.next
+	i.nextIndex++
+	return i.lastReturned
+}
+
+// Previous moves the cursor backward and returns the node it passed over
+func (i *ListIteratorOf[T]) Previous() *NodeOf[T] {
+	if !i.HasPrevious() {
+		return nil
+	}
+	if i.next == nil {
+		i.next = i.list.Tail
+	} else {
+		i.next = i.next.previous
+	}
+	i.nextIndex--
+	i.lastReturned = i.next
+	return i.lastReturned
+}
+
+// NextIndex returns the index of the node that Next would return
+func (i *ListIteratorOf[T]) NextIndex() int {
+	return i.nextIndex
+}
+
+// PreviousIndex returns the index of the node that Previous would return
+func (i *ListIteratorOf[T]) PreviousIndex() int {
+	return i.nextIndex - 1
+}
+
+// Remove removes the node last returned by Next or Previous
+func (i *ListIteratorOf[T]) Remove() error {
+	if i.modified() {
+		return i.err
+	}
+	if i.lastReturned == nil {
+		return ErrNoCurrentNode
+	}
+	if i.lastReturned == i.next {
+		// returned by Previous, so the cursor stays at the same index
+		i.next = i.next.next
+	} else {
+		i.nextIndex--
+	}
+	i.list.unlink(i.lastReturned)
+	i.lastReturned = nil
+	i.expectedModCount = i.list.modCount
+	return nil
+}
+
+// Set replaces the node last returned by Next or Previous.
+// It panics with ErrNodeOwned if the node already belongs to a list.
+func (i *ListIteratorOf[T]) Set(node *NodeOf[T]) error {
+	node.mustBeDetached()
+	if i.modified() {
+		return i.err
+	}
+	if i.lastReturned == nil {
+		return ErrNoCurrentNode
+	}
+	if i.lastReturned == i.next {
+		i.next = node
+	}
+	mark := i.lastReturned.previous
+	i.list.unlink(i.lastReturned)
+	i.list.linkAfter(node, mark)
+	i.lastReturned = node
+	i.expectedModCount = i.list.modCount
+	return nil
+}
+
+// Add inserts a node at the cursor, before the node Next would return. A
+// following call to Next is unaffected and a call to Previous returns the
+// new node.
+// It panics with ErrNodeOwned if the node already belongs to a list.
+func (i *ListIteratorOf[T]) Add(node *NodeOf[T]) error {
+	node.mustBeDetached()
+	if i.modified() {
+		return i.err
+	}
+	i.list.linkBefore(node, i.next)
+	i.nextIndex++
+	i.lastReturned = nil
+	i.expectedModCount = i.list.modCount
+	return nil
+}
@@ -0,0 +1,152 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func TestListIteratorTraversal(t *testing.T) {
+	list := newList(1, 2, 3)
+	it := list.ListIterator()
+
+	ok(t, !it.HasPrevious())
+	ok(t, it.Previous() == nil)
+
+	collect := []int{}
+	for it.HasNext() {
+		ok(t, it.NextIndex() == len(collect))
+		collect = append(collect, it.Next().Value.(int))
+	}
+	assertSlicesAreEqual(t, []int{1, 2, 3}, collect)
+	ok(t, it.Next() == nil)
+	ok(t, it.NextIndex() == 3)
+	ok(t, it.PreviousIndex() == 2)
+
+	collect = []int{}
+	for it.HasPrevious() {
+		collect = append(collect, it.Previous().Value.(int))
+	}
+	assertSlicesAreEqual(t, []int{3, 2, 1}, collect)
+	ok(t, it.PreviousIndex() == -1)
+
+	assertNodeValue(t, 1, it.Next())
+	assertNodeValue(t, 1, it.Previous())
+}
+
+func TestListIteratorAt(t *testing.T) {
+	list := newList(1, 2, 3)
+
+	it, err := list.ListIteratorAt(2)
+	ok(t, err == nil)
+	assertNodeValue(t, 3, it.Next())
+
+	it, err = list.ListIteratorAt(3)
+	ok(t, err == nil)
+	ok(t, !it.HasNext())
+	assertNodeValue(t, 3, it.Previous())
+
+	_, err = list.ListIteratorAt(4)
+	ok(t, err == linkedlist.ErrIndexOutOfRange)
+}
+
+func TestListIteratorRemove(t *testing.T) {
+	t.Run("filter in one pass", func(t *testing.T) {
+		list := newList(1, 2, 3, 4, 5, 6)
+		it := list.ListIterator()
+		for it.HasNext() {
+			if it.Next().Value.(int)%2 == 0 {
+				ok(t, it.Remove() == nil)
+			}
+		}
+		assertListNodes(t, list, 1, 3, 5)
+	})
+	t.Run("after previous", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		it, _ := list.ListIteratorAt(3)
+		assertNodeValue(t, 3, it.Previous())
+		ok(t, it.Remove() == nil)
+		ok(t, it.NextIndex() == 2)
+		assertNodeValue(t, 2, it.Previous())
+		ok(t, it.Remove() == nil)
+		assertListNodes(t, list, 1)
+	})
+	t.Run("without current node", func(t *testing.T) {
+		list := newList(1, 2)
+		it := list.ListIterator()
+		ok(t, it.Remove() == linkedlist.ErrNoCurrentNode)
+		it.Next()
+		ok(t, it.Remove() == nil)
+		ok(t, it.Remove() == linkedlist.ErrNoCurrentNode)
+		assertListNodes(t, list, 2)
+	})
+}
+
+func TestListIteratorSet(t *testing.T) {
+	list := newList(1, 2, 3)
+	it := list.ListIterator()
+	ok(t, it.Set(&linkedlist.Node{Value: 0}) == linkedlist.ErrNoCurrentNode)
+	for it.HasNext() {
+		node := it.Next()
+		ok(t, it.Set(&linkedlist.Node{Value: node.Value.(int) * 10}) == nil)
+	}
+	assertListNodes(t, list, 10, 20, 30)
+
+	assertNodeValue(t, 30, it.Previous())
+	ok(t, it.Set(&linkedlist.Node{Value: 300}) == nil)
+	assertNodeValue(t, 300, it.Next())
+	assertPanics(t, linkedlist.ErrNodeOwned, func() { it.Set(list.Head) })
+	assertListNodes(t, list, 10, 20, 300)
+}
+
+func TestListIteratorAdd(t *testing.T) {
+	list := newList(2, 4)
+	it := list.ListIterator()
+
+	ok(t, it.Add(&linkedlist.Node{Value: 1}) == nil)
+	ok(t, it.NextIndex() == 1)
+	assertNodeValue(t, 2, it.Next())
+	ok(t, it.Add(&linkedlist.Node{Value: 3}) == nil)
+	assertNodeValue(t, 3, it.Previous())
+	assertNodeValue(t, 3, it.Next())
+	assertNodeValue(t, 4, it.Next())
+	ok(t, it.Add(&linkedlist.Node{Value: 5}) == nil)
+	ok(t, !it.HasNext())
+	ok(t, it.Remove() == linkedlist.ErrNoCurrentNode)
+	assertPanics(t, linkedlist.ErrNodeOwned, func() { it.Add(list.Head) })
+
+	assertListNodes(t, list, 1, 2, 3, 4, 5)
+}
+
+func TestListIteratorConcurrentModification(t *testing.T) {
+	t.Run("modified outside the iterator", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		it := list.ListIterator()
+		assertDetectsModification(t, func() error {
+			it.Next()
+			list.RemoveTail()
+			ok(t, it.Next() == nil)
+			return it.Err()
+		})
+	})
+	t.Run("mutating through the iterator", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		it := list.ListIterator()
+		assertDetectsModification(t, func() error {
+			it.Next()
+			list.AddToStart(&linkedlist.Node{Value: 0})
+			return it.Remove()
+		})
+	})
+	t.Run("own modifications", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		it := list.ListIterator()
+		for it.HasNext() {
+			node := it.Next()
+			ok(t, it.Remove() == nil)
+			ok(t, it.Add(&linkedlist.Node{Value: node.Value}) == nil)
+		}
+		ok(t, it.Err() == nil)
+		assertListNodes(t, list, 1, 2, 3)
+	})
+}
@@ -0,0 +1,85 @@
+package linkedlist
+
+// The value methods wrap values in nodes taken from a free list kept by the
+// list, and put those nodes back on it when they remove them, so that a
+// list which grows and shrinks around a steady size stops allocating.
+// Nodes added by the caller are never recycled, but nodes created by the
+// value methods are, so they must not be retained once removed.
+//
+// The free list is part of the list value, so a list that uses the value
+// methods, or a Queue or Stack built on one, must not be copied. If a copy
+// is made anyway, a list drops its pool as soon as it finds a pooled node
+// that a copy has taken, rather than linking the node into two lists.
+
+// MaxPoolSize is the most nodes a list keeps for reuse
+const MaxPoolSize = 1024
+
+// AddValue appends a node holding value to the end of the list
+func (l *LinkedListOf[T]) AddValue(value T) {
+	l.linkAfter(l.newNode(value), l.Tail)
+}
+
+// AddValueToStart adds a node holding value to the beginning of the list
+func (l *LinkedListOf[T]) AddValueToStart(value T) {
+	l.linkBefore(l.newNode(value), l.Head)
+}
+
+// RemoveHeadValue removes the first node and returns its value
+func (l *LinkedListOf[T]) RemoveHeadValue() (T, bool) {
+	node := l.RemoveHead()
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+	return l.release(node), true
+}
+
+// RemoveTailValue removes the last node and returns its value
+func (l *LinkedListOf[T]) RemoveTailValue() (T, bool) {
+	node := l.RemoveTail()
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+	return l.release(node), true
+}
+
+// ReleasePool drops the nodes kept for reuse so they can be collected
+func (l *LinkedListOf[T]) ReleasePool() {
+	l.free = nil
+	l.freeSize = 0
+}
+
+// newNode returns a pooled node holding value, or a new one if the pool is
+// empty
+func (l *LinkedListOf[T]) newNode(value T) *NodeOf[T] {
+	node := l.free
+	if node != nil && (!node.IsDetached() || l.freeSize == 0) {
+		// a copy of this list shares the pool and has taken the node
+		l.ReleasePool()
+		node = nil
+	}
+	if node == nil {
+		return &NodeOf[T]{Value: value, pooled: true}
+	}
+	l.free = node.next
+	l.freeSize--
+	node.next = nil
+	node.Value = value
+	return node
+}
+
+// release returns the value held by a detached node, and puts the node in
+// the pool if the value methods created it and the pool has room
+func (l *LinkedListOf[T]) release(node *NodeOf[T]) T {
+	value := node.Value
+	if !node.pooled || l.freeSize == MaxPoolSize {
+		return value
+	}
+	var zero T
+	node.Value = zero
+	node.next = l.free
+	l.free = node
+	l.freeSize++
+	return value
+}
@@ -0,0 +1,97 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func TestAddValue(t *testing.T) {
+	list := linkedlist.New()
+	list.AddValue(2)
+	list.AddValue(3)
+	list.AddValueToStart(1)
+	assertListNodes(t, list, 1, 2, 3)
+}
+
+func TestRemoveValue(t *testing.T) {
+	list := newList(1, 2, 3)
+	v, found := list.RemoveHeadValue()
+	ok(t, found && v == 1)
+	v, found = list.RemoveTailValue()
+	ok(t, found && v == 3)
+	assertListNodes(t, list, 2)
+	v, found = list.RemoveTailValue()
+	ok(t, found && v == 2)
+	assertListIsEmpty(t, list)
+
+	_, found = list.RemoveHeadValue()
+	ok(t, !found)
+	_, found = list.RemoveTailValue()
+	ok(t, !found)
+}
+
+func TestPoolReusesNodes(t *testing.T) {
+	list := linkedlist.NewOf[int]()
+	list.AddValue(1)
+	node := list.Head
+	list.RemoveHeadValue()
+	ok(t, node.Value == 0)
+	ok(t, node.IsDetached())
+
+	list.AddValue(2)
+	ok(t, list.Head == node)
+	ok(t, node.Value == 2)
+	ok(t, node.Next() == nil && node.Previous() == nil)
+	ok(t, list.Validate() == nil)
+
+	list.RemoveHeadValue()
+	list.ReleasePool()
+	list.AddValue(3)
+	ok(t, list.Head != node)
+}
+
+func TestPoolSkipsCallerNodes(t *testing.T) {
+	list := linkedlist.NewOf[int]()
+	node := &linkedlist.NodeOf[int]{Value: 1}
+	list.Add(node)
+	v, found := list.RemoveHeadValue()
+	ok(t, found && v == 1)
+	list.AddValue(9)
+	ok(t, list.Head != node)
+	ok(t, node.Value == 1)
+}
+
+func TestPoolIsBounded(t *testing.T) {
+	list := linkedlist.NewOf[int]()
+	n := linkedlist.MaxPoolSize + 10
+	used := map[*linkedlist.NodeOf[int]]bool{}
+	for i := 0; i < n; i++ {
+		list.AddValue(i)
+		used[list.Tail] = true
+	}
+	for i := 0; i < n; i++ {
+		list.RemoveHeadValue()
+	}
+	reused := 0
+	for i := 0; i < n; i++ {
+		list.AddValue(i)
+		if used[list.Tail] {
+			reused++
+		}
+	}
+	ok(t, reused == linkedlist.MaxPoolSize)
+}
+
+func TestPoolSharedByCopy(t *testing.T) {
+	a := linkedlist.NewOf[int]()
+	a.AddValue(1)
+	a.RemoveHeadValue()
+	b := a
+	a.AddValue(2)
+	b.AddValue(3)
+	ok(t, a.Head != b.Head)
+	ok(t, a.Head.Value == 2 && b.Head.Value == 3)
+	ok(t, a.Validate() == nil)
+	ok(t, b.Validate() == nil)
+}
@@ -0,0 +1,50 @@
+package linkedlist
+
+import "math/rand"
+
+// Reverse reverses the order of the list in place
+func (l *LinkedListOf[T]) Reverse() {
+	if l.size < 2 {
+		return
+	}
+	for node := l.Head; node != nil; node = node.previous {
+		node.next, node.previous = node.previous, node.next
+	}
+	l.Head, l.Tail = l.Tail, l.Head
+	l.modCount++
+	l.verify()
+}
+
+// Rotate moves the last k nodes to the start of the list. A negative k
+// rotates the other way, moving the first -k nodes to the end.
+func (l *LinkedListOf[T]) Rotate(k int) {
+	if l.size < 2 {
+		return
+	}
+	k %= l.size
+	if k < 0 {
+		k += l.size
+	}
+	if k == 0 {
+		return
+	}
+	head := l.nodeAt(l.size - k)
+	// close the ring, then open it again in front of the new head
+	l.Tail.next = l.Head
+	l.Head.previous = l.Tail
+	l.Head = head
+	l.Tail = head.previous
+	l.Head.previous = nil
+	l.Tail.next = nil
+	l.modCount++
+	l.verify()
+}
+
+// Shuffle puts the list in a uniformly random order using r. It relinks the
+// existing nodes with a randomized merge, so it runs in O(n log n) time
+// without allocating.
+func (l *LinkedListOf[T]) Shuffle(r *rand.Rand) {
+	l.merge(func(left, right *NodeOf[T], leftSize, rightSize int) bool {
+		return r.Intn(leftSize+rightSize) >= leftSize
+	})
+}
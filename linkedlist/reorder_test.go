@@ -0,0 +1,78 @@
+package linkedlist_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	list := newList(1, 2, 3, 4)
+	list.Reverse()
+	assertListNodes(t, list, 4, 3, 2, 1)
+	ok(t, list.Validate() == nil)
+
+	list = newList(1)
+	list.Reverse()
+	assertListNodes(t, list, 1)
+
+	list = newList()
+	list.Reverse()
+	assertListIsEmpty(t, list)
+}
+
+func TestRotate(t *testing.T) {
+	cases := []struct {
+		k        int
+		expected []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{1, []int{5, 1, 2, 3, 4}},
+		{2, []int{4, 5, 1, 2, 3}},
+		{5, []int{1, 2, 3, 4, 5}},
+		{7, []int{4, 5, 1, 2, 3}},
+		{-1, []int{2, 3, 4, 5, 1}},
+		{-7, []int{3, 4, 5, 1, 2}},
+	}
+	for _, c := range cases {
+		list := newList(1, 2, 3, 4, 5)
+		list.Rotate(c.k)
+		assertListNodes(t, list, c.expected...)
+		ok(t, list.Validate() == nil)
+	}
+
+	list := newList()
+	list.Rotate(3)
+	assertListIsEmpty(t, list)
+}
+
+func TestShuffle(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	t.Run("keeps every node", func(t *testing.T) {
+		list := newList(1, 2, 3, 4, 5, 6, 7, 8, 9)
+		list.Shuffle(r)
+		ok(t, list.Validate() == nil)
+		values := []int{}
+		for v := range list.Values() {
+			values = append(values, v.(int))
+		}
+		sort.Ints(values)
+		assertSlicesAreEqual(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, values)
+	})
+
+	t.Run("every order is possible", func(t *testing.T) {
+		seen := map[[3]int]int{}
+		for i := 0; i < 6000; i++ {
+			list := newList(1, 2, 3)
+			list.Shuffle(r)
+			slice := list.ToSlice()
+			seen[[3]int{slice[0].Value.(int), slice[1].Value.(int), slice[2].Value.(int)}]++
+		}
+		ok(t, len(seen) == 6)
+		for _, count := range seen {
+			// each order is expected 1000 times
+			ok(t, count > 800 && count < 1200)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package linkedlist
+
+import "iter"
+
+// RingOf is a circular linked list holding values of type T. Every node's
+// next and previous links are set, so walking forward from the last node
+// arrives back at the first. The ring keeps a cursor on its current node.
+type RingOf[T any] struct {
+	current *NodeOf[T]
+	size    int
+	owner   *listID
+}
+
+// NewRingOf returns an empty ring holding values of type T
+func NewRingOf[T any]() RingOf[T] {
+	return RingOf[T]{}
+}
+
+func (r *RingOf[T]) id() *listID {
+	if r.owner == nil {
+		r.owner = &listID{}
+	}
+	return r.owner
+}
+
+// Owns indicates if the node is currently linked into this ring
+func (r *RingOf[T]) Owns(node *NodeOf[T]) bool {
+	if node == nil || node.list == nil || r.owner == nil {
+		return false
+	}
+	return node.list.resolve() == r.owner
+}
+
+// Size returns the total number of nodes in the ring
+func (r *RingOf[T]) Size() int {
+	return r.size
+}
+
+// Current returns the node at the cursor, or nil if the ring is empty
+func (r *RingOf[T]) Current() *NodeOf[T] {
+	return r.current
+}
+
+// Add links a node in just before the current node, so that it is the last
+// node visited in a lap starting from the current node. The first node added
+// becomes the current node.
+// It panics with ErrNodeOwned if the node already belongs to a list.
+func (r *RingOf[T]) Add(node *NodeOf[T]) {
+	node.mustBeDetached()
+	node.list = r.id()
+	if r.current == nil {
+		node.next = node
+		node.previous = node
+		r.current = node
+	} else {
+		last := r.current.previous
+		node.previous = last
+		node.next = r.current
+		last.next = node
+		r.current.previous = node
+	}
+	r.size++
+}
+
+// Advance moves the cursor n nodes forward, or backward if n is negative
+func (r *RingOf[T]) Advance(n int) {
+	if r.size == 0 {
+		return
+	}
+	n %= r.size
+	if n < 0 {
+		n += r.size
+	}
+	// take the shorter way around
+	if n > r.size/2 {
+		for i := n; i < r.size; i++ {
+			r.current = r.current.previous
+		}
+		return
+	}
+	for i := 0; i < n; i++ {
+		r.current = r.current.next
+	}
+}
+
+// RemoveCurrent removes and returns the current node. The node after it
+// becomes the current node.
+func (r *RingOf[T]) RemoveCurrent() *NodeOf[T] {
+	removed := r.current
+	if removed == nil {
+		return nil
+	}
+	if r.size == 1 {
+		r.current = nil
+	} else {
+		removed.previous.next = removed.next
+		removed.next.previous = removed.previous
+		r.current = removed.next
+	}
+	removed.next = nil
+	removed.previous = nil
+	removed.list = nil
+	r.size--
+	return removed
+}
+
+// All returns a sequence that makes exactly one lap of the ring, starting at
+// the current node. The node being visited may be removed from the ring
+// during iteration.
+func (r *RingOf[T]) All() iter.Seq[*NodeOf[T]] {
+	return func(yield func(*NodeOf[T]) bool) {
+		node := r.current
+		for i, size := 0, r.size; i < size && r.size > 0; i++ {
+			next := node.next
+			if !yield(node) {
+				return
+			}
+			node = next
+		}
+	}
+}
+
+// Eliminate repeatedly counts k nodes around the ring, starting with the
+// current node, and removes the k-th until the ring is empty. It returns the
+// removed nodes in the order they were removed.
+func (r *RingOf[T]) Eliminate(k int) []*NodeOf[T] {
+	if k < 1 {
+		return nil
+	}
+	removed := make([]*NodeOf[T], 0, r.size)
+	for r.size > 0 {
+		r.Advance(k - 1)
+		removed = append(removed, r.RemoveCurrent())
+	}
+	return removed
+}
+
+// Josephus returns the order in which n people standing in a circle, numbered
+// from 1, are eliminated when every k-th person is removed. The last number
+// is the survivor.
+func Josephus(n, k int) []int {
+	ring := NewRingOf[int]()
+	for i := 1; i <= n; i++ {
+		ring.Add(&NodeOf[int]{Value: i})
+	}
+	order := make([]int, 0, n)
+	for _, node := range ring.Eliminate(k) {
+		order = append(order, node.Value)
+	}
+	return order
+}
@@ -0,0 +1,129 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func newRing(nums ...int) linkedlist.Ring {
+	ring := linkedlist.NewRing()
+	for _, num := range nums {
+		ring.Add(&linkedlist.Node{Value: num})
+	}
+	return ring
+}
+
+func assertRingNodes(t *testing.T, ring linkedlist.Ring, expected ...int) {
+	collect := []int{}
+	for node := range ring.All() {
+		collect = append(collect, node.Value.(int))
+	}
+	assertSlicesAreEqual(t, expected, collect)
+	ok(t, ring.Size() == len(expected))
+	if len(expected) > 0 {
+		// the last node links back round to the current node
+		ok(t, ring.Current().Previous().Next() == ring.Current())
+		ok(t, ring.Current().Previous().Value == expected[len(expected)-1])
+	}
+}
+
+func TestRingAdd(t *testing.T) {
+	ring := newRing()
+	ok(t, ring.Current() == nil)
+	assertRingNodes(t, ring)
+
+	ring = newRing(1)
+	ok(t, ring.Current().Next() == ring.Current())
+	assertRingNodes(t, ring, 1)
+
+	ring = newRing(1, 2, 3)
+	assertRingNodes(t, ring, 1, 2, 3)
+
+	assertPanics(t, linkedlist.ErrNodeOwned, func() { ring.Add(ring.Current()) })
+	list := newList(4)
+	assertPanics(t, linkedlist.ErrNodeOwned, func() { ring.Add(list.Head) })
+	assertPanics(t, linkedlist.ErrNodeOwned, func() { list.Add(ring.Current()) })
+	ok(t, ring.Owns(ring.Current()))
+	ok(t, !list.Owns(ring.Current()))
+}
+
+func TestRingAdvance(t *testing.T) {
+	ring := newRing(1, 2, 3, 4, 5)
+	ring.Advance(1)
+	assertRingNodes(t, ring, 2, 3, 4, 5, 1)
+	ring.Advance(4)
+	assertRingNodes(t, ring, 1, 2, 3, 4, 5)
+	ring.Advance(-1)
+	assertRingNodes(t, ring, 5, 1, 2, 3, 4)
+	ring.Advance(12)
+	assertRingNodes(t, ring, 2, 3, 4, 5, 1)
+	ring.Advance(-11)
+	assertRingNodes(t, ring, 1, 2, 3, 4, 5)
+
+	empty := newRing()
+	empty.Advance(3)
+	ok(t, empty.Current() == nil)
+}
+
+func TestRingRemoveCurrent(t *testing.T) {
+	ring := newRing(1, 2, 3)
+	node := ring.RemoveCurrent()
+	assertNodeValue(t, 1, node)
+	ok(t, node.IsDetached())
+	ok(t, node.Next() == nil && node.Previous() == nil)
+	assertRingNodes(t, ring, 2, 3)
+
+	ring.Advance(1)
+	assertNodeValue(t, 3, ring.RemoveCurrent())
+	assertRingNodes(t, ring, 2)
+
+	assertNodeValue(t, 2, ring.RemoveCurrent())
+	assertRingNodes(t, ring)
+	ok(t, ring.RemoveCurrent() == nil)
+
+	list := newList()
+	list.Add(node)
+	assertListNodes(t, list, 1)
+}
+
+func TestRingAllBreak(t *testing.T) {
+	ring := newRing(1, 2, 3)
+	count := 0
+	for range ring.All() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	ok(t, count == 2)
+}
+
+func TestRingAllRemoveCurrent(t *testing.T) {
+	ring := newRing(1, 2, 3)
+	visited := []int{}
+	for node := range ring.All() {
+		visited = append(visited, node.Value.(int))
+		ok(t, ring.RemoveCurrent() == node)
+	}
+	assertSlicesAreEqual(t, []int{1, 2, 3}, visited)
+	ok(t, ring.Size() == 0)
+	ok(t, ring.Current() == nil)
+}
+
+func TestJosephus(t *testing.T) {
+	assertSlicesAreEqual(t, []int{2, 4, 6, 3, 1, 5}, linkedlist.Josephus(6, 2))
+	assertSlicesAreEqual(t, []int{3, 6, 2, 7, 5, 1, 4}, linkedlist.Josephus(7, 3))
+	assertSlicesAreEqual(t, []int{1, 2, 3}, linkedlist.Josephus(3, 1))
+	assertSlicesAreEqual(t, []int{}, linkedlist.Josephus(0, 2))
+
+	// the survivor for k = 2 follows the closed form 2L+1 where n = 2^m + L
+	for n := 1; n < 70; n++ {
+		order := linkedlist.Josephus(n, 2)
+		m := 1
+		for m*2 <= n {
+			m *= 2
+		}
+		ok(t, order[len(order)-1] == 2*(n-m)+1)
+	}
+}
@@ -0,0 +1,43 @@
+package linkedlist
+
+import "iter"
+
+// All returns a sequence of the nodes in the list from Head to Tail.
+// The node being visited may be removed from the list during iteration.
+func (l *LinkedListOf[T]) All() iter.Seq[*NodeOf[T]] {
+	return func(yield func(*NodeOf[T]) bool) {
+		for node := l.Head; node != nil; {
+			next := node.next
+			if !yield(node) {
+				return
+			}
+			node = next
+		}
+	}
+}
+
+// Values returns a sequence of the values in the list from Head to Tail
+func (l *LinkedListOf[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := range l.All() {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence of index and node pairs from Tail to Head.
+// The node being visited may be removed from the list during iteration.
+func (l *LinkedListOf[T]) Backward() iter.Seq2[int, *NodeOf[T]] {
+	return func(yield func(int, *NodeOf[T]) bool) {
+		i := l.size - 1
+		for node := l.Tail; node != nil; i-- {
+			previous := node.previous
+			if !yield(i, node) {
+				return
+			}
+			node = previous
+		}
+	}
+}
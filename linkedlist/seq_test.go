@@ -0,0 +1,73 @@
+package linkedlist_test
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	list := newList(1, 2, 3)
+	collect := []int{}
+	for node := range list.All() {
+		collect = append(collect, node.Value.(int))
+	}
+	assertSlicesAreEqual(t, []int{1, 2, 3}, collect)
+
+	t.Run("break", func(t *testing.T) {
+		collect := []int{}
+		for node := range list.All() {
+			if node.Value == 2 {
+				break
+			}
+			collect = append(collect, node.Value.(int))
+		}
+		assertSlicesAreEqual(t, []int{1}, collect)
+	})
+
+	t.Run("remove while iterating", func(t *testing.T) {
+		list := newList(1, 2, 3, 4)
+		for node := range list.All() {
+			if node.Value.(int)%2 == 0 {
+				ok(t, list.Remove(node) == nil)
+			}
+		}
+		assertListNodes(t, list, 1, 3)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		list := newList()
+		for range list.All() {
+			t.Fatal("empty list yielded a node")
+		}
+	})
+}
+
+func TestValues(t *testing.T) {
+	list := newList(1, 2, 3)
+	values := slices.Collect(list.Values())
+	ok(t, len(values) == 3)
+	for i, v := range values {
+		ok(t, v == i+1)
+	}
+}
+
+func TestBackward(t *testing.T) {
+	list := newList(1, 2, 3)
+	indexes := []int{}
+	collect := []int{}
+	for i, node := range list.Backward() {
+		indexes = append(indexes, i)
+		collect = append(collect, node.Value.(int))
+	}
+	assertSlicesAreEqual(t, []int{2, 1, 0}, indexes)
+	assertSlicesAreEqual(t, []int{3, 2, 1}, collect)
+
+	collect = []int{}
+	for _, node := range list.Backward() {
+		if node.Value == 1 {
+			break
+		}
+		collect = append(collect, node.Value.(int))
+	}
+	assertSlicesAreEqual(t, []int{3, 2}, collect)
+}
@@ -0,0 +1,95 @@
+package linkedlist
+
+// LessFnOf reports whether node a should sort before node b
+type LessFnOf[T any] func(a, b *NodeOf[T]) bool
+
+// Sort orders the list using less. It is a stable, bottom-up merge sort that
+// relinks the existing nodes, so it runs in O(n log n) time without
+// allocating.
+func (l *LinkedListOf[T]) Sort(less LessFnOf[T]) {
+	l.merge(func(left, right *NodeOf[T], leftSize, rightSize int) bool {
+		// ties take from the left run to keep the sort stable
+		return less(right, left)
+	})
+}
+
+// merge runs a bottom-up merge over the list, merging runs of doubling width.
+// takeRight decides which run the next node of a merge comes from, given the
+// heads of both runs and the number of nodes left in each.
+func (l *LinkedListOf[T]) merge(takeRight func(left, right *NodeOf[T], leftSize, rightSize int) bool) {
+	if l.size < 2 {
+		return
+	}
+	head := l.Head
+	for width := 1; width < l.size; width *= 2 {
+		var merged, tail *NodeOf[T]
+		left := head
+		for left != nil {
+			right, leftSize := advance(left, width)
+			rest, rightSize := advance(right, width)
+			for leftSize > 0 || rightSize > 0 {
+				var node *NodeOf[T]
+				if rightSize == 0 || (leftSize > 0 && !takeRight(left, right, leftSize, rightSize)) {
+					node, left = left, left.next
+					leftSize--
+				} else {
+					node, right = right, right.next
+					rightSize--
+				}
+				if tail == nil {
+					merged = node
+				} else {
+					tail.next = node
+				}
+				tail = node
+			}
+			left = rest
+		}
+		tail.next = nil
+		head = merged
+	}
+
+	var previous *NodeOf[T]
+	for node := head; node != nil; node = node.next {
+		node.previous = previous
+		previous = node
+	}
+	l.Head = head
+	l.Tail = previous
+	l.modCount++
+	l.verify()
+}
+
+// advance follows up to n next links and returns the node it stopped at and
+// how many links it followed
+func advance[T any](node *NodeOf[T], n int) (*NodeOf[T], int) {
+	count := 0
+	for count < n && node != nil {
+		node = node.next
+		count++
+	}
+	return node, count
+}
+
+// IsSorted indicates if the list is ordered according to less
+func (l *LinkedListOf[T]) IsSorted(less LessFnOf[T]) bool {
+	for node := l.Head; node != nil && node.next != nil; node = node.next {
+		if less(node.next, node) {
+			return false
+		}
+	}
+	return true
+}
+
+// InsertSorted inserts a node into a list that is already ordered by less,
+// after any nodes that are equal to it.
+// It panics with ErrNodeOwned if the node already belongs to a list.
+func (l *LinkedListOf[T]) InsertSorted(node *NodeOf[T], less LessFnOf[T]) {
+	for mark := l.Head; mark != nil; mark = mark.next {
+		if less(node, mark) {
+			l.linkBefore(node, mark)
+			return
+		}
+	}
+	l.linkAfter(node, l.Tail)
+}
@@ -0,0 +1,85 @@
+package linkedlist_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func lessInt(a, b *linkedlist.Node) bool {
+	return a.Value.(int) < b.Value.(int)
+}
+
+func TestSort(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		list := newList()
+		list.Sort(lessInt)
+		assertListIsEmpty(t, list)
+	})
+	t.Run("one", func(t *testing.T) {
+		list := newList(1)
+		list.Sort(lessInt)
+		assertListNodes(t, list, 1)
+	})
+	t.Run("reversed", func(t *testing.T) {
+		list := newList(5, 4, 3, 2, 1)
+		list.Sort(lessInt)
+		assertListNodes(t, list, 1, 2, 3, 4, 5)
+		ok(t, list.Validate() == nil)
+	})
+	t.Run("random", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		for n := 1; n < 100; n++ {
+			values := r.Perm(n)
+			list := newList(values...)
+			list.Sort(lessInt)
+			sort.Ints(values)
+			assertListNodes(t, list, values...)
+			ok(t, list.IsSorted(lessInt))
+			ok(t, list.Validate() == nil)
+		}
+	})
+	t.Run("stable", func(t *testing.T) {
+		// sort by tens only, the units record the original order
+		list := newList(31, 20, 32, 10, 21, 33, 11)
+		list.Sort(func(a, b *linkedlist.Node) bool {
+			return a.Value.(int)/10 < b.Value.(int)/10
+		})
+		assertListNodes(t, list, 10, 11, 20, 21, 31, 32, 33)
+	})
+	t.Run("keeps nodes", func(t *testing.T) {
+		list := newList(2, 1)
+		nodes := list.ToSlice()
+		list.Sort(lessInt)
+		ok(t, list.Head == nodes[1])
+		ok(t, list.Tail == nodes[0])
+	})
+}
+
+func TestIsSorted(t *testing.T) {
+	sorted := [][]int{{}, {1}, {1, 1, 2}}
+	for _, values := range sorted {
+		list := newList(values...)
+		ok(t, list.IsSorted(lessInt))
+	}
+	list := newList(1, 3, 2)
+	ok(t, !list.IsSorted(lessInt))
+}
+
+func TestInsertSorted(t *testing.T) {
+	list := newList()
+	for _, v := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		list.InsertSorted(&linkedlist.Node{Value: v}, lessInt)
+	}
+	assertListNodes(t, list, 1, 1, 2, 3, 4, 5, 6, 9)
+
+	t.Run("equal values go last", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		node := &linkedlist.Node{Value: 2}
+		list.InsertSorted(node, lessInt)
+		_, got := list.Get(2)
+		ok(t, got == node)
+	})
+}
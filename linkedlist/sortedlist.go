@@ -0,0 +1,218 @@
+package linkedlist
+
+import "iter"
+
+// DuplicatePolicy decides what a SortedListOf does with a node that compares
+// equal to one it already holds
+type DuplicatePolicy int
+
+const (
+	// AllowDuplicates keeps equal nodes in the order they were added
+	AllowDuplicates DuplicatePolicy = iota
+	// RejectDuplicates refuses equal nodes with ErrDuplicate
+	RejectDuplicates
+	// ReplaceDuplicates makes Add unlink the equal node and put the new one
+	// in its place. Set already replaces the node at its index, so it
+	// refuses equal neighbours with ErrDuplicate as RejectDuplicates does.
+	ReplaceDuplicates
+)
+
+// SortedListOf is a list holding values of type T that keeps its nodes in
+// the order given by a comparator. It only offers operations that cannot
+// break that order, so there is no AddToStart, InsertAt or Swap, and Set
+// refuses nodes that do not fit at their index. Create one with NewSortedOf,
+// which supplies the order. The zero value reads as an empty list, but Add
+// and Set panic with ErrNoComparator.
+type SortedListOf[T any] struct {
+	list    LinkedListOf[T]
+	compare func(a, b T) int
+	policy  DuplicatePolicy
+}
+
+// NewSortedOf returns an empty sorted list ordered by compare, which returns
+// a negative number when a < b, zero when a == b and a positive number when
+// a > b
+func NewSortedOf[T any](compare func(a, b T) int, policy DuplicatePolicy) SortedListOf[T] {
+	return SortedListOf[T]{compare: compare, policy: policy}
+}
+
+// Add inserts a node after any nodes that are less than or equal to it.
+// It returns ErrDuplicate if the list rejects duplicates and already holds
+// an equal node, and panics with ErrNodeOwned if the node already belongs
+// to a list.
+func (s *SortedListOf[T]) Add(node *NodeOf[T]) error {
+	s.mustHaveComparator()
+	node.mustBeDetached()
+	// Walk back from Tail so that adding in ascending order is O(1)
+	mark := s.list.Tail
+	for mark != nil && s.compare(node.Value, mark.Value) < 0 {
+		mark = mark.previous
+	}
+	if mark != nil && s.compare(node.Value, mark.Value) == 0 {
+		switch s.policy {
+		case RejectDuplicates:
+			return ErrDuplicate
+		case ReplaceDuplicates:
+			previous := mark.previous
+			s.list.unlink(mark)
+			s.list.linkAfter(node, previous)
+			return nil
+		}
+	}
+	s.list.linkAfter(node, mark)
+	return nil
+}
+
+// Set replaces the node at the specified index. It returns ErrOutOfOrder if
+// the node does not sort between its neighbours, and ErrDuplicate if the
+// list does not allow duplicates and a neighbour is equal to it.
+// It panics with ErrNodeOwned if the node already belongs to a list.
+func (s *SortedListOf[T]) Set(index int, node *NodeOf[T]) error {
+	s.mustHaveComparator()
+	node.mustBeDetached()
+	if index < 0 || index >= s.list.size {
+		return ErrIndexOutOfRange
+	}
+	old := s.list.nodeAt(index)
+	if previous := old.previous; previous != nil {
+		if err := s.inOrder(previous.Value, node.Value); err != nil {
+			return err
+		}
+	}
+	if next := old.next; next != nil {
+		if err := s.inOrder(node.Value, next.Value); err != nil {
+			return err
+		}
+	}
+	previous := old.previous
+	s.list.unlink(old)
+	s.list.linkAfter(node, previous)
+	return nil
+}
+
+func (s *SortedListOf[T]) mustHaveComparator() {
+	if s.compare == nil {
+		panic(ErrNoComparator)
+	}
+}
+
+// inOrder returns an error unless a value a may sit directly before b
+func (s *SortedListOf[T]) inOrder(a, b T) error {
+	c := s.compare(a, b)
+	if c > 0 {
+		return ErrOutOfOrder
+	}
+	if c == 0 && s.policy != AllowDuplicates {
+		return ErrDuplicate
+	}
+	return nil
+}
+
+// Remove removes the node from the list in constant time
+func (s *SortedListOf[T]) Remove(node *NodeOf[T]) error {
+	return s.list.Remove(node)
+}
+
+// RemoveHead removes the first node, which holds the least value
+func (s *SortedListOf[T]) RemoveHead() *NodeOf[T] {
+	return s.list.RemoveHead()
+}
+
+// RemoveTail removes the last node, which holds the greatest value
+func (s *SortedListOf[T]) RemoveTail() *NodeOf[T] {
+	return s.list.RemoveTail()
+}
+
+// Clear removes all nodes from the list
+func (s *SortedListOf[T]) Clear() {
+	s.list.Clear()
+}
+
+// Head returns the first node, which holds the least value
+func (s *SortedListOf[T]) Head() *NodeOf[T] {
+	return s.list.Head
+}
+
+// Tail returns the last node, which holds the greatest value
+func (s *SortedListOf[T]) Tail() *NodeOf[T] {
+	return s.list.Tail
+}
+
+// Size returns the total number of nodes in the list
+func (s *SortedListOf[T]) Size() int {
+	return s.list.Size()
+}
+
+// Get returns the node at the specified index
+func (s *SortedListOf[T]) Get(index int) (bool, *NodeOf[T]) {
+	return s.list.Get(index)
+}
+
+// Owns indicates if the node is currently linked into this list
+func (s *SortedListOf[T]) Owns(node *NodeOf[T]) bool {
+	return s.list.Owns(node)
+}
+
+// Find returns the first node holding a value equal to value
+func (s *SortedListOf[T]) Find(value T) (bool, *NodeOf[T]) {
+	node := s.ceiling(value)
+	if node == nil || s.compare(node.Value, value) != 0 {
+		return false, nil
+	}
+	return true, node
+}
+
+// Floor returns the last node holding the greatest value less than or equal
+// to value
+func (s *SortedListOf[T]) Floor(value T) (bool, *NodeOf[T]) {
+	node := s.list.Tail
+	for node != nil && s.compare(node.Value, value) > 0 {
+		node = node.previous
+	}
+	return node != nil, node
+}
+
+// Ceiling returns the first node holding the least value greater than or
+// equal to value
+func (s *SortedListOf[T]) Ceiling(value T) (bool, *NodeOf[T]) {
+	node := s.ceiling(value)
+	return node != nil, node
+}
+
+func (s *SortedListOf[T]) ceiling(value T) *NodeOf[T] {
+	node := s.list.Head
+	for node != nil && s.compare(node.Value, value) < 0 {
+		node = node.next
+	}
+	return node
+}
+
+// RangeBetween returns a sequence of the nodes holding values from lo to hi
+// inclusive, in order
+func (s *SortedListOf[T]) RangeBetween(lo, hi T) iter.Seq[*NodeOf[T]] {
+	return func(yield func(*NodeOf[T]) bool) {
+		for node := s.ceiling(lo); node != nil && s.compare(node.Value, hi) <= 0; {
+			next := node.next
+			if !yield(node) {
+				return
+			}
+			node = next
+		}
+	}
+}
+
+// All returns a sequence of the nodes in order.
+// The node being visited may be removed from the list during iteration.
+func (s *SortedListOf[T]) All() iter.Seq[*NodeOf[T]] {
+	return s.list.All()
+}
+
+// Values returns a sequence of the values in order
+func (s *SortedListOf[T]) Values() iter.Seq[T] {
+	return s.list.Values()
+}
+
+// ToSlice returns a slice of the nodes in order
+func (s *SortedListOf[T]) ToSlice() []*NodeOf[T] {
+	return s.list.ToSlice()
+}
@@ -0,0 +1,191 @@
+package linkedlist_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+// item sorts by key only, so tests can tell equal items apart by name
+type item struct {
+	key  int
+	name string
+}
+
+func compareItems(a, b item) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+func newSorted(policy linkedlist.DuplicatePolicy, keys ...int) linkedlist.SortedListOf[item] {
+	s := linkedlist.NewSortedOf(compareItems, policy)
+	for _, k := range keys {
+		s.Add(&linkedlist.NodeOf[item]{Value: item{key: k}})
+	}
+	return s
+}
+
+func assertSortedKeys(t *testing.T, s linkedlist.SortedListOf[item], expected ...int) {
+	if s.Size() != len(expected) {
+		t.Fatal("list size is unexpected - got: ", s.Size(), " expected: ", len(expected))
+	}
+	keys := []int{}
+	for v := range s.Values() {
+		keys = append(keys, v.key)
+	}
+	assertSlicesAreEqual(t, expected, keys)
+}
+
+func TestSortedAdd(t *testing.T) {
+	s := newSorted(linkedlist.AllowDuplicates, 5, 1, 4, 2, 3, 0, 6)
+	assertSortedKeys(t, s, 0, 1, 2, 3, 4, 5, 6)
+	ok(t, s.Head().Value.key == 0)
+	ok(t, s.Tail().Value.key == 6)
+}
+
+func TestSortedAddIsStable(t *testing.T) {
+	s := linkedlist.NewSortedOf(compareItems, linkedlist.AllowDuplicates)
+	for _, it := range []item{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}, {2, "e"}} {
+		ok(t, s.Add(&linkedlist.NodeOf[item]{Value: it}) == nil)
+	}
+	names := ""
+	for v := range s.Values() {
+		names += v.name
+	}
+	ok(t, names == "bdace")
+}
+
+func TestSortedDuplicatePolicies(t *testing.T) {
+	s := newSorted(linkedlist.RejectDuplicates, 1, 2, 3)
+	ok(t, s.Add(&linkedlist.NodeOf[item]{Value: item{2, "new"}}) == linkedlist.ErrDuplicate)
+	assertSortedKeys(t, s, 1, 2, 3)
+
+	s = newSorted(linkedlist.ReplaceDuplicates, 1, 2, 3)
+	_, old := s.Find(item{key: 2})
+	replacement := &linkedlist.NodeOf[item]{Value: item{2, "new"}}
+	ok(t, s.Add(replacement) == nil)
+	assertSortedKeys(t, s, 1, 2, 3)
+	_, node := s.Get(1)
+	ok(t, node == replacement)
+	ok(t, old.IsDetached())
+	ok(t, s.Add(&linkedlist.NodeOf[item]{Value: item{1, "first"}}) == nil)
+	ok(t, s.Head().Value.name == "first")
+}
+
+func TestSortedAddOwnedNode(t *testing.T) {
+	s := newSorted(linkedlist.AllowDuplicates, 1)
+	assertPanics(t, linkedlist.ErrNodeOwned, func() { s.Add(s.Head()) })
+}
+
+func TestSortedZeroValue(t *testing.T) {
+	var s linkedlist.SortedListOf[item]
+	assertSortedKeys(t, s)
+	found, _ := s.Find(item{key: 1})
+	ok(t, !found)
+	found, _ = s.Floor(item{key: 1})
+	ok(t, !found)
+	found, _ = s.Ceiling(item{key: 1})
+	ok(t, !found)
+	for range s.RangeBetween(item{key: 0}, item{key: 9}) {
+		t.Fatal("zero value yielded a node")
+	}
+	node := &linkedlist.NodeOf[item]{Value: item{key: 1}}
+	assertPanics(t, linkedlist.ErrNoComparator, func() { s.Add(node) })
+	assertPanics(t, linkedlist.ErrNoComparator, func() { s.Set(0, node) })
+	ok(t, node.IsDetached())
+}
+
+func TestSortedSet(t *testing.T) {
+	s := newSorted(linkedlist.AllowDuplicates, 10, 20, 30)
+	ok(t, s.Set(1, &linkedlist.NodeOf[item]{Value: item{key: 25}}) == nil)
+	assertSortedKeys(t, s, 10, 25, 30)
+	ok(t, s.Set(1, &linkedlist.NodeOf[item]{Value: item{key: 5}}) == linkedlist.ErrOutOfOrder)
+	ok(t, s.Set(1, &linkedlist.NodeOf[item]{Value: item{key: 35}}) == linkedlist.ErrOutOfOrder)
+	ok(t, s.Set(0, &linkedlist.NodeOf[item]{Value: item{key: 25}}) == nil)
+	ok(t, s.Set(2, &linkedlist.NodeOf[item]{Value: item{key: 99}}) == nil)
+	assertSortedKeys(t, s, 25, 25, 99)
+	ok(t, s.Set(3, &linkedlist.NodeOf[item]{}) == linkedlist.ErrIndexOutOfRange)
+	ok(t, s.Set(-1, &linkedlist.NodeOf[item]{}) == linkedlist.ErrIndexOutOfRange)
+
+	s = newSorted(linkedlist.RejectDuplicates, 10, 20, 30)
+	ok(t, s.Set(1, &linkedlist.NodeOf[item]{Value: item{key: 10}}) == linkedlist.ErrDuplicate)
+	ok(t, s.Set(1, &linkedlist.NodeOf[item]{Value: item{key: 20}}) == nil)
+	assertSortedKeys(t, s, 10, 20, 30)
+
+	s = newSorted(linkedlist.ReplaceDuplicates, 1, 2)
+	ok(t, s.Set(1, &linkedlist.NodeOf[item]{Value: item{key: 1}}) == linkedlist.ErrDuplicate)
+	ok(t, s.Set(1, &linkedlist.NodeOf[item]{Value: item{key: 2, name: "new"}}) == nil)
+	assertSortedKeys(t, s, 1, 2)
+	ok(t, s.Tail().Value.name == "new")
+}
+
+func TestSortedRemove(t *testing.T) {
+	s := newSorted(linkedlist.AllowDuplicates, 1, 2, 3, 4)
+	ok(t, s.RemoveHead().Value.key == 1)
+	ok(t, s.RemoveTail().Value.key == 4)
+	_, node := s.Find(item{key: 3})
+	ok(t, s.Owns(node))
+	ok(t, s.Remove(node) == nil)
+	ok(t, s.Remove(node) == linkedlist.ErrNodeNotInList)
+	assertSortedKeys(t, s, 2)
+	s.Clear()
+	assertSortedKeys(t, s)
+}
+
+func TestSortedFloorCeiling(t *testing.T) {
+	s := linkedlist.NewSortedOf(compareItems, linkedlist.AllowDuplicates)
+	for _, it := range []item{{10, "a"}, {20, "b"}, {20, "c"}, {30, "d"}} {
+		s.Add(&linkedlist.NodeOf[item]{Value: it})
+	}
+
+	found, node := s.Floor(item{key: 20})
+	ok(t, found && node.Value.name == "c")
+	found, node = s.Floor(item{key: 25})
+	ok(t, found && node.Value.name == "c")
+	found, _ = s.Floor(item{key: 5})
+	ok(t, !found)
+
+	found, node = s.Ceiling(item{key: 20})
+	ok(t, found && node.Value.name == "b")
+	found, node = s.Ceiling(item{key: 15})
+	ok(t, found && node.Value.name == "b")
+	found, _ = s.Ceiling(item{key: 35})
+	ok(t, !found)
+
+	found, node = s.Find(item{key: 20})
+	ok(t, found && node.Value.name == "b")
+	found, _ = s.Find(item{key: 25})
+	ok(t, !found)
+}
+
+func TestSortedRangeBetween(t *testing.T) {
+	s := newSorted(linkedlist.AllowDuplicates, 1, 3, 3, 5, 7, 9)
+	keys := []int{}
+	for node := range s.RangeBetween(item{key: 3}, item{key: 7}) {
+		keys = append(keys, node.Value.key)
+	}
+	assertSlicesAreEqual(t, []int{3, 3, 5, 7}, keys)
+
+	for node := range s.RangeBetween(item{key: 2}, item{key: 6}) {
+		ok(t, s.Remove(node) == nil)
+	}
+	assertSortedKeys(t, s, 1, 7, 9)
+
+	for range s.RangeBetween(item{key: 10}, item{key: 20}) {
+		t.Fatal("range past the end should be empty")
+	}
+}
+
+func TestNewSorted(t *testing.T) {
+	s := linkedlist.NewSorted(func(a, b interface{}) int {
+		return cmp.Compare(a.(int), b.(int))
+	}, linkedlist.AllowDuplicates)
+	for _, v := range []int{3, 1, 2} {
+		s.Add(&linkedlist.Node{Value: v})
+	}
+	values := []int{}
+	for _, node := range s.ToSlice() {
+		values = append(values, node.Value.(int))
+	}
+	assertSlicesAreEqual(t, []int{1, 2, 3}, values)
+}
@@ -0,0 +1,157 @@
+package linkedlist
+
+// Concat moves every node of other to the end of this list in constant
+// time, leaving other empty. Nothing happens if other is this list or a
+// copy of it.
+func (l *LinkedListOf[T]) Concat(other *LinkedListOf[T]) {
+	if l.shares(other) || other.size == 0 {
+		return
+	}
+	if l.Tail == nil {
+		l.Head = other.Head
+	} else {
+		l.Tail.next = other.Head
+		other.Head.previous = l.Tail
+	}
+	l.Tail = other.Tail
+	l.size += other.size
+	// the nodes keep the ids they carry, which now resolve to this list
+	l.owner = union(l.id(), other.owner)
+	other.owner = nil
+	other.Head = nil
+	other.Tail = nil
+	other.size = 0
+	l.modCount++
+	other.modCount++
+	other.verify()
+	l.verify()
+}
+
+// SpliceAfter moves the nodes from "from" to "to" (inclusive) out of other
+// and links them after mark. A nil mark moves them to the start of this list.
+// The list and other may be the same list, as long as mark is not inside
+// the range, but it returns ErrSharedList if other is a copy of this list.
+func (l *LinkedListOf[T]) SpliceAfter(mark *NodeOf[T], other *LinkedListOf[T], from, to *NodeOf[T]) error {
+	if other != l && l.shares(other) {
+		return ErrSharedList
+	}
+	if mark != nil && !l.Owns(mark) {
+		return ErrNodeNotInList
+	}
+	if !other.Owns(from) || !other.Owns(to) {
+		return ErrNodeNotInList
+	}
+	count := 1
+	for node := from; node != to; node = node.next {
+		if node == nil || node == mark {
+			return ErrInvalidRange
+		}
+		count++
+	}
+	if to == mark {
+		return ErrInvalidRange
+	}
+
+	// cut the run out of other
+	if from.previous == nil {
+		other.Head = to.next
+	} else {
+		from.previous.next = to.next
+	}
+	if to.next == nil {
+		other.Tail = from.previous
+	} else {
+		to.next.previous = from.previous
+	}
+	other.size -= count
+	other.modCount++
+
+	// link the run in after mark
+	var next *NodeOf[T]
+	if mark == nil {
+		next = l.Head
+		l.Head = from
+	} else {
+		next = mark.next
+		mark.next = from
+	}
+	from.previous = mark
+	to.next = next
+	if next == nil {
+		l.Tail = to
+	} else {
+		next.previous = to
+	}
+	l.size += count
+	l.modCount++
+
+	if other != l {
+		id := l.id()
+		for node := from; node != next; node = node.next {
+			node.list = id
+		}
+	}
+	other.verify()
+	l.verify()
+	return nil
+}
+
+// SplitAt cuts the list in two. This list keeps the nodes before index and
+// the nodes from index onwards are moved to the returned list.
+func (l *LinkedListOf[T]) SplitAt(index int) (LinkedListOf[T], error) {
+	split := NewOf[T]()
+	if index < 0 || index > l.size {
+		return split, ErrIndexOutOfRange
+	}
+	if index == l.size {
+		return split, nil
+	}
+	node := l.nodeAt(index)
+	split.Head = node
+	split.Tail = l.Tail
+	split.size = l.size - index
+	if node.previous == nil {
+		l.Head = nil
+	} else {
+		node.previous.next = nil
+	}
+	l.Tail = node.previous
+	l.size = index
+	node.previous = nil
+	id := split.id()
+	for ; node != nil; node = node.next {
+		node.list = id
+	}
+	l.modCount++
+	l.verify()
+	split.verify()
+	return split, nil
+}
+
+// MergeSorted merges two lists that are ordered by less into a new ordered
+// list, leaving both a and b empty. Nodes from a come before equal nodes
+// from b. If a and b are the same list, or copies of it, its nodes are moved
+// to the new list as they are.
+func MergeSorted[T any](a, b *LinkedListOf[T], less LessFnOf[T]) LinkedListOf[T] {
+	merged := NewOf[T]()
+	if a.shares(b) {
+		merged.Concat(a)
+		*b = NewOf[T]()
+		return merged
+	}
+	for a.Head != nil && b.Head != nil {
+		if less(b.Head, a.Head) {
+			merged.Add(b.RemoveHead())
+		} else {
+			merged.Add(a.RemoveHead())
+		}
+	}
+	merged.Concat(a)
+	merged.Concat(b)
+	return merged
+}
+
+// shares indicates if other is this list or a copy of it, sharing its nodes
+func (l *LinkedListOf[T]) shares(other *LinkedListOf[T]) bool {
+	return other == l || (l.owner != nil && other.owner == l.owner)
+}
@@ -0,0 +1,255 @@
+package linkedlist_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func TestConcat(t *testing.T) {
+	t.Run("populated lists", func(t *testing.T) {
+		a := newList(1, 2)
+		b := newList(3, 4)
+		moved := b.Head
+		a.Concat(&b)
+		assertListNodes(t, a, 1, 2, 3, 4)
+		assertListIsEmpty(t, b)
+		ok(t, a.Owns(moved))
+		ok(t, !b.Owns(moved))
+		ok(t, a.Validate() == nil)
+	})
+	t.Run("into empty list", func(t *testing.T) {
+		a := newList()
+		b := newList(1, 2)
+		a.Concat(&b)
+		assertListNodes(t, a, 1, 2)
+		assertListIsEmpty(t, b)
+	})
+	t.Run("empty other", func(t *testing.T) {
+		a := newList(1)
+		b := newList()
+		a.Concat(&b)
+		assertListNodes(t, a, 1)
+	})
+	t.Run("itself", func(t *testing.T) {
+		a := newList(1, 2)
+		a.Concat(&a)
+		assertListNodes(t, a, 1, 2)
+	})
+	t.Run("copy of itself", func(t *testing.T) {
+		a := newList(1, 2)
+		b := a
+		a.Concat(&b)
+		assertListNodes(t, a, 1, 2)
+		ok(t, a.Owns(a.Head))
+		ok(t, a.Remove(a.Head) == nil)
+		assertListNodes(t, a, 2)
+	})
+	t.Run("repeatedly", func(t *testing.T) {
+		a := newList(1)
+		b := newList(2)
+		c := newList(3)
+		node := c.Head
+		b.Concat(&c)
+		a.Concat(&b)
+		ok(t, a.Owns(node))
+		ok(t, a.Remove(node) == nil)
+		c.Add(node)
+		ok(t, c.Owns(node))
+		ok(t, !a.Owns(node))
+		assertListNodes(t, a, 1, 2)
+		assertListNodes(t, c, 3)
+	})
+	t.Run("chained", func(t *testing.T) {
+		lists := make([]linkedlist.LinkedList, 5)
+		for i := range lists {
+			lists[i] = newList(i)
+		}
+		nodes := []*linkedlist.Node{lists[0].Head, lists[1].Head, lists[2].Head}
+		lists[1].Concat(&lists[0])
+		lists[2].Concat(&lists[1])
+		lists[3].Concat(&lists[2])
+		lists[4].Concat(&lists[3])
+		for _, node := range nodes {
+			ok(t, lists[4].Owns(node))
+			ok(t, !lists[3].Owns(node))
+		}
+		assertListNodes(t, lists[4], 4, 3, 2, 1, 0)
+	})
+	t.Run("accumulated", func(t *testing.T) {
+		acc := newList(0)
+		first := acc.Head
+		for i := 1; i <= 1000; i++ {
+			next := newList(i)
+			next.Concat(&acc)
+			acc = next
+		}
+		ok(t, acc.Size() == 1001)
+		for node := range acc.All() {
+			if linkedlist.OwnerDepth(node) > 10 {
+				t.Fatal("ownership chain is too long: ", linkedlist.OwnerDepth(node))
+			}
+		}
+		ok(t, acc.Owns(first))
+		ok(t, acc.Validate() == nil)
+	})
+	t.Run("concurrent readers", func(t *testing.T) {
+		a := newList(1)
+		b := newList(2, 3)
+		a.Concat(&b)
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if a.Validate() != nil || !a.Owns(a.Tail) {
+					t.Error("list is invalid")
+				}
+			}()
+		}
+		wg.Wait()
+	})
+	t.Run("other is reusable", func(t *testing.T) {
+		a := newList(1)
+		b := newList(2)
+		a.Concat(&b)
+		b.Add(&linkedlist.Node{Value: 3})
+		ok(t, !a.Owns(b.Head))
+		assertListNodes(t, a, 1, 2)
+		assertListNodes(t, b, 3)
+	})
+}
+
+func TestSpliceAfter(t *testing.T) {
+	t.Run("copy of itself", func(t *testing.T) {
+		a := newList(1, 2, 3)
+		b := a
+		ok(t, a.SpliceAfter(a.Tail, &b, b.Head, b.Head) == linkedlist.ErrSharedList)
+		assertListNodes(t, a, 1, 2, 3)
+		ok(t, a.Validate() == nil)
+	})
+	t.Run("between lists", func(t *testing.T) {
+		a := newList(1, 5)
+		b := newList(10, 2, 3, 4, 11)
+		nodes := b.ToSlice()
+		ok(t, a.SpliceAfter(a.Head, &b, nodes[1], nodes[3]) == nil)
+		assertListNodes(t, a, 1, 2, 3, 4, 5)
+		assertListNodes(t, b, 10, 11)
+		ok(t, a.Owns(nodes[2]))
+		ok(t, a.Validate() == nil)
+		ok(t, b.Validate() == nil)
+	})
+	t.Run("to start", func(t *testing.T) {
+		a := newList(3)
+		b := newList(1, 2)
+		ok(t, a.SpliceAfter(nil, &b, b.Head, b.Tail) == nil)
+		assertListNodes(t, a, 1, 2, 3)
+		assertListIsEmpty(t, b)
+	})
+	t.Run("to end", func(t *testing.T) {
+		a := newList(1)
+		b := newList(2, 3)
+		ok(t, a.SpliceAfter(a.Tail, &b, b.Head, b.Head) == nil)
+		assertListNodes(t, a, 1, 2)
+		assertListNodes(t, b, 3)
+	})
+	t.Run("within one list", func(t *testing.T) {
+		list := newList(1, 4, 5, 2, 3)
+		nodes := list.ToSlice()
+		ok(t, list.SpliceAfter(nodes[0], &list, nodes[3], nodes[4]) == nil)
+		assertListNodes(t, list, 1, 2, 3, 4, 5)
+		ok(t, list.Validate() == nil)
+	})
+	t.Run("mark inside range", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		nodes := list.ToSlice()
+		ok(t, list.SpliceAfter(nodes[1], &list, nodes[0], nodes[2]) == linkedlist.ErrInvalidRange)
+		ok(t, list.SpliceAfter(nodes[2], &list, nodes[0], nodes[2]) == linkedlist.ErrInvalidRange)
+		assertListNodes(t, list, 1, 2, 3)
+	})
+	t.Run("reversed range", func(t *testing.T) {
+		a := newList(1)
+		b := newList(2, 3)
+		ok(t, a.SpliceAfter(a.Head, &b, b.Tail, b.Head) == linkedlist.ErrInvalidRange)
+		assertListNodes(t, a, 1)
+		assertListNodes(t, b, 2, 3)
+	})
+	t.Run("foreign nodes", func(t *testing.T) {
+		a := newList(1)
+		b := newList(2, 3)
+		ok(t, a.SpliceAfter(b.Head, &b, b.Head, b.Tail) == linkedlist.ErrNodeNotInList)
+		ok(t, a.SpliceAfter(a.Head, &b, a.Head, b.Tail) == linkedlist.ErrNodeNotInList)
+		assertListNodes(t, a, 1)
+		assertListNodes(t, b, 2, 3)
+	})
+}
+
+func TestSplitAt(t *testing.T) {
+	t.Run("middle", func(t *testing.T) {
+		list := newList(1, 2, 3, 4)
+		split, err := list.SplitAt(2)
+		ok(t, err == nil)
+		assertListNodes(t, list, 1, 2)
+		assertListNodes(t, split, 3, 4)
+		ok(t, split.Owns(split.Head))
+		ok(t, !list.Owns(split.Head))
+		ok(t, list.Validate() == nil)
+		ok(t, split.Validate() == nil)
+	})
+	t.Run("start", func(t *testing.T) {
+		list := newList(1, 2)
+		split, err := list.SplitAt(0)
+		ok(t, err == nil)
+		assertListIsEmpty(t, list)
+		assertListNodes(t, split, 1, 2)
+	})
+	t.Run("end", func(t *testing.T) {
+		list := newList(1, 2)
+		split, err := list.SplitAt(2)
+		ok(t, err == nil)
+		assertListNodes(t, list, 1, 2)
+		assertListIsEmpty(t, split)
+	})
+	t.Run("out of range", func(t *testing.T) {
+		list := newList(1, 2)
+		_, err := list.SplitAt(3)
+		ok(t, err == linkedlist.ErrIndexOutOfRange)
+		assertListNodes(t, list, 1, 2)
+	})
+}
+
+func TestMergeSorted(t *testing.T) {
+	a := newList(1, 3, 5, 7)
+	b := newList(2, 3, 4)
+	fromB := b.ToSlice()[1]
+	merged := linkedlist.MergeSorted(&a, &b, lessInt)
+	assertListNodes(t, merged, 1, 2, 3, 3, 4, 5, 7)
+	assertListIsEmpty(t, a)
+	assertListIsEmpty(t, b)
+	_, node := merged.Get(3)
+	ok(t, node == fromB)
+	ok(t, merged.Validate() == nil)
+
+	t.Run("copies of one list", func(t *testing.T) {
+		a := newList(1, 2)
+		b := a
+		merged := linkedlist.MergeSorted(&a, &b, lessInt)
+		assertListNodes(t, merged, 1, 2)
+		assertListIsEmpty(t, a)
+		assertListIsEmpty(t, b)
+		ok(t, merged.Validate() == nil)
+	})
+	t.Run("same list", func(t *testing.T) {
+		a := newList(1, 2)
+		merged := linkedlist.MergeSorted(&a, &a, lessInt)
+		assertListNodes(t, merged, 1, 2)
+		assertListIsEmpty(t, a)
+	})
+	t.Run("one empty", func(t *testing.T) {
+		a := newList()
+		b := newList(1, 2)
+		merged := linkedlist.MergeSorted(&a, &b, lessInt)
+		assertListNodes(t, merged, 1, 2)
+	})
+}
@@ -0,0 +1,153 @@
+package linkedlist
+
+import "iter"
+
+// SubListOf is a live view over a range of nodes in a list holding values of
+// type T. The view shares its nodes with the list. Changes made through the
+// view are made to the list, and any structural change made to the list
+// outside the view makes the view invalid.
+type SubListOf[T any] struct {
+	list             *LinkedListOf[T]
+	first            *NodeOf[T]
+	last             *NodeOf[T]
+	size             int
+	expectedModCount int
+	err              error
+}
+
+// SubList returns a view of the nodes from index from (inclusive) to index
+// to (exclusive)
+func (l *LinkedListOf[T]) SubList(from, to int) (SubListOf[T], error) {
+	if from < 0 || to > l.size || from > to {
+		return SubListOf[T]{}, ErrIndexOutOfRange
+	}
+	view := SubListOf[T]{list: l, size: to - from, expectedModCount: l.modCount}
+	if from < to {
+		view.first = l.nodeAt(from)
+		view.last = l.nodeAt(to - 1)
+	}
+	return view, nil
+}
+
+// Err returns ErrConcurrentModification if the list was structurally
+// modified outside the view, otherwise nil
+func (s *SubListOf[T]) Err() error {
+	s.modified()
+	return s.err
+}
+
+// modified records ErrConcurrentModification if the list changed other than
+// through this view. Built with the linkedlistdebug tag it panics instead.
+func (s *SubListOf[T]) modified() bool {
+	if s.err == nil && s.list != nil && s.list.modCount != s.expectedModCount {
+		s.err = ErrConcurrentModification
+		if debug {
+			panic(s.err)
+		}
+	}
+	return s.err != nil
+}
+
+// Size returns the number of nodes in the view, or 0 once the view is
+// invalid, in which case Err reports why
+func (s *SubListOf[T]) Size() int {
+	if s.modified() {
+		return 0
+	}
+	return s.size
+}
+
+// Get returns the node at the specified index of the view
+func (s *SubListOf[T]) Get(index int) (*NodeOf[T], error) {
+	if s.modified() {
+		return nil, s.err
+	}
+	if index < 0 || index >= s.size {
+		return nil, ErrIndexOutOfRange
+	}
+	if index < s.size/2 {
+		node := s.first
+		for i := 0; i < index; i++ {
+			node = node.next
+		}
+		return node, nil
+	}
+	node := s.last
+	for i := s.size - 1; i > index; i-- {
+		node = node.previous
+	}
+	return node, nil
+}
+
+// All returns a sequence of the nodes in the view. It stops early, setting
+// Err, if the list is modified outside the view.
+func (s *SubListOf[T]) All() iter.Seq[*NodeOf[T]] {
+	return func(yield func(*NodeOf[T]) bool) {
+		node := s.first
+		for i := 0; i < s.size; i++ {
+			if s.modified() {
+				return
+			}
+			if !yield(node) {
+				return
+			}
+			node = node.next
+		}
+	}
+}
+
+// Clear removes every node in the view from the list
+func (s *SubListOf[T]) Clear() error {
+	if s.modified() {
+		return s.err
+	}
+	for node := s.first; s.size > 0; s.size-- {
+		next := node.next
+		s.list.unlink(node)
+		node = next
+	}
+	s.first = nil
+	s.last = nil
+	s.expectedModCount = s.list.modCount
+	return nil
+}
+
+// Sort orders just the nodes in the view using less, leaving the rest of
+// the list untouched. See LinkedListOf.Sort.
+func (s *SubListOf[T]) Sort(less LessFnOf[T]) error {
+	if s.modified() {
+		return s.err
+	}
+	if s.size < 2 {
+		return nil
+	}
+	l := s.list
+	before := s.first.previous
+	after := s.last.next
+
+	// sort the range as a list of its own that shares the owner, so the
+	// nodes do not need to be restamped
+	s.first.previous = nil
+	s.last.next = nil
+	run := LinkedListOf[T]{Head: s.first, Tail: s.last, size: s.size, owner: l.owner}
+	run.Sort(less)
+
+	run.Head.previous = before
+	run.Tail.next = after
+	if before == nil {
+		l.Head = run.Head
+	} else {
+		before.next = run.Head
+	}
+	if after == nil {
+		l.Tail = run.Tail
+	} else {
+		after.previous = run.Tail
+	}
+	s.first = run.Head
+	s.last = run.Tail
+	l.modCount++
+	s.expectedModCount = l.modCount
+	l.verify()
+	return nil
+}
@@ -0,0 +1,117 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func collectSubList(t *testing.T, view linkedlist.SubList) []int {
+	collect := []int{}
+	for node := range view.All() {
+		collect = append(collect, node.Value.(int))
+	}
+	return collect
+}
+
+func TestSubList(t *testing.T) {
+	list := newList(1, 2, 3, 4, 5)
+	view, err := list.SubList(1, 4)
+	ok(t, err == nil)
+	ok(t, view.Size() == 3)
+	assertSlicesAreEqual(t, []int{2, 3, 4}, collectSubList(t, view))
+
+	node, err := view.Get(0)
+	ok(t, err == nil)
+	assertNodeValue(t, 2, node)
+	node, err = view.Get(2)
+	ok(t, err == nil)
+	assertNodeValue(t, 4, node)
+	_, err = view.Get(3)
+	ok(t, err == linkedlist.ErrIndexOutOfRange)
+
+	_, node = list.Get(2)
+	viewNode, _ := view.Get(1)
+	ok(t, node == viewNode)
+
+	_, err = list.SubList(2, 6)
+	ok(t, err == linkedlist.ErrIndexOutOfRange)
+	_, err = list.SubList(3, 2)
+	ok(t, err == linkedlist.ErrIndexOutOfRange)
+
+	empty, err := list.SubList(2, 2)
+	ok(t, err == nil)
+	ok(t, empty.Size() == 0)
+	assertSlicesAreEqual(t, []int{}, collectSubList(t, empty))
+}
+
+func TestSubListClear(t *testing.T) {
+	t.Run("middle", func(t *testing.T) {
+		list := newList(1, 2, 3, 4, 5)
+		view, _ := list.SubList(1, 4)
+		ok(t, view.Clear() == nil)
+		ok(t, view.Size() == 0)
+		ok(t, view.Err() == nil)
+		assertListNodes(t, list, 1, 5)
+	})
+	t.Run("whole list", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		view, _ := list.SubList(0, 3)
+		ok(t, view.Clear() == nil)
+		assertListIsEmpty(t, list)
+	})
+}
+
+func TestSubListSort(t *testing.T) {
+	t.Run("middle", func(t *testing.T) {
+		list := newList(9, 4, 3, 2, 1, 0)
+		view, _ := list.SubList(1, 5)
+		ok(t, view.Sort(lessInt) == nil)
+		assertListNodes(t, list, 9, 1, 2, 3, 4, 0)
+		assertSlicesAreEqual(t, []int{1, 2, 3, 4}, collectSubList(t, view))
+		ok(t, list.Validate() == nil)
+	})
+	t.Run("whole list", func(t *testing.T) {
+		list := newList(3, 1, 2)
+		view, _ := list.SubList(0, 3)
+		ok(t, view.Sort(lessInt) == nil)
+		assertListNodes(t, list, 1, 2, 3)
+		ok(t, list.Validate() == nil)
+	})
+	t.Run("then clear", func(t *testing.T) {
+		list := newList(5, 3, 4, 1)
+		view, _ := list.SubList(0, 3)
+		ok(t, view.Sort(lessInt) == nil)
+		ok(t, view.Clear() == nil)
+		assertListNodes(t, list, 1)
+	})
+}
+
+func TestSubListInvalidated(t *testing.T) {
+	list := newList(1, 2, 3, 4)
+	view, _ := list.SubList(1, 3)
+	list.RemoveHead()
+
+	other := newList(1, 2, 3)
+	sized, _ := other.SubList(0, 2)
+	other.RemoveHead()
+	assertDetectsModification(t, func() error {
+		ok(t, sized.Size() == 0)
+		return sized.Err()
+	})
+
+	assertDetectsModification(t, view.Err)
+	assertDetectsModification(t, func() error {
+		_, err := view.Get(0)
+		return err
+	})
+	assertDetectsModification(t, view.Clear)
+	assertDetectsModification(t, func() error {
+		return view.Sort(lessInt)
+	})
+	assertDetectsModification(t, func() error {
+		collectSubList(t, view)
+		return view.Err()
+	})
+	assertListNodes(t, list, 2, 3, 4)
+}
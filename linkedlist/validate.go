@@ -0,0 +1,153 @@
+package linkedlist
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ViolationKind describes how a list breaks one of its invariants
+type ViolationKind int
+
+const (
+	// HeadHasPrevious means the head node links to a previous node
+	HeadHasPrevious ViolationKind = iota
+	// TailHasNext means the tail node links to a next node
+	TailHasNext
+	// BrokenBackLink means a node's previous link does not point at the node before it
+	BrokenBackLink
+	// ForeignNode means a node reachable from the list is not owned by it
+	ForeignNode
+	// Cycle means walking the list revisits a node
+	Cycle
+	// HeadMismatch means walking backwards from Tail does not end at Head
+	HeadMismatch
+	// TailMismatch means walking forwards from Head does not end at Tail
+	TailMismatch
+	// SizeMismatch means the number of reachable nodes differs from Size
+	SizeMismatch
+)
+
+var violationKindNames = [...]string{
+	HeadHasPrevious: "head has previous",
+	TailHasNext:     "tail has next",
+	BrokenBackLink:  "broken back link",
+	ForeignNode:     "foreign node",
+	Cycle:           "cycle",
+	HeadMismatch:    "head mismatch",
+	TailMismatch:    "tail mismatch",
+	SizeMismatch:    "size mismatch",
+}
+
+func (k ViolationKind) String() string {
+	if k < 0 || int(k) >= len(violationKindNames) {
+		return fmt.Sprintf("ViolationKind(%d)", int(k))
+	}
+	return violationKindNames[k]
+}
+
+// Violation is a single broken invariant found at an index of the list.
+// Indexes count from Head, except for HeadMismatch which counts from Tail.
+type Violation struct {
+	Index int
+	Kind  ViolationKind
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s at index %d", v.Kind, v.Index)
+}
+
+// ValidationError lists every violation found by Validate
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.String()
+	}
+	return "linkedlist: invalid list: " + strings.Join(parts, "; ")
+}
+
+// Has indicates if a violation of the specified kind was found
+func (e *ValidationError) Has(kind ViolationKind) bool {
+	for _, v := range e.Violations {
+		if v.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate walks the list in both directions and checks that Head, Tail,
+// size, ownership and the previous links all agree with the chain of next
+// links. It returns a *ValidationError describing every problem it finds.
+func (l *LinkedListOf[T]) Validate() error {
+	var violations []Violation
+	report := func(index int, kind ViolationKind) {
+		violations = append(violations, Violation{Index: index, Kind: kind})
+	}
+
+	if l.Head != nil && l.Head.previous != nil {
+		report(0, HeadHasPrevious)
+	}
+
+	seen := map[*NodeOf[T]]bool{}
+	var last *NodeOf[T]
+	count := 0
+	for node := l.Head; node != nil; node = node.next {
+		if seen[node] {
+			report(count, Cycle)
+			break
+		}
+		seen[node] = true
+		if !l.Owns(node) {
+			report(count, ForeignNode)
+		}
+		if node.previous != last {
+			report(count, BrokenBackLink)
+		}
+		last = node
+		count++
+	}
+	if last != l.Tail {
+		report(count-1, TailMismatch)
+	}
+	if l.Tail != nil && l.Tail.next != nil {
+		report(count-1, TailHasNext)
+	}
+	if count != l.size {
+		report(count, SizeMismatch)
+	}
+
+	seen = map[*NodeOf[T]]bool{}
+	var first *NodeOf[T]
+	index := 0
+	for node := l.Tail; node != nil; node = node.previous {
+		if seen[node] {
+			report(index, Cycle)
+			break
+		}
+		seen[node] = true
+		first = node
+		index++
+	}
+	if first != l.Head {
+		report(index-1, HeadMismatch)
+	}
+
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// verify panics if the list is invalid and the package was built with the
+// linkedlistdebug tag
+func (l *LinkedListOf[T]) verify() {
+	if debug {
+		if err := l.Validate(); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package linkedlist_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		list := newList()
+		ok(t, list.Validate() == nil)
+	})
+	t.Run("after mutations", func(t *testing.T) {
+		list := newList(1, 2, 3, 4)
+		list.RemoveHead()
+		list.InsertAfter(buildMatcherFn(2), &linkedlist.Node{Value: 5})
+		list.Set(1, &linkedlist.Node{Value: 6})
+		list.RemoveTail()
+		list.AddToStart(&linkedlist.Node{Value: 7})
+		ok(t, list.Validate() == nil)
+		assertListNodes(t, list, 7, 2, 6, 3)
+	})
+	t.Run("tail is not last node", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		list.Tail = list.Head
+		err := assertValidationError(t, list)
+		ok(t, err.Has(linkedlist.TailMismatch))
+		ok(t, err.Has(linkedlist.TailHasNext))
+		ok(t, !err.Has(linkedlist.SizeMismatch))
+	})
+	t.Run("foreign head", func(t *testing.T) {
+		list := newList(1, 2, 3)
+		list.Head = &linkedlist.Node{Value: 0}
+		err := assertValidationError(t, list)
+		ok(t, err.Violations[0] == linkedlist.Violation{Index: 0, Kind: linkedlist.ForeignNode})
+		ok(t, err.Has(linkedlist.SizeMismatch))
+		ok(t, err.Has(linkedlist.TailMismatch))
+		ok(t, err.Has(linkedlist.HeadMismatch))
+	})
+	t.Run("broken back link", func(t *testing.T) {
+		a := &linkedlist.Node{Value: 1}
+		b := &linkedlist.Node{Value: 2}
+		a.LinkNext(b)
+		list := newList()
+		list.Head = a
+		list.Tail = b
+		err := assertValidationError(t, list)
+		ok(t, err.Has(linkedlist.BrokenBackLink))
+		ok(t, strings.Contains(err.Error(), "broken back link at index 1"))
+	})
+	t.Run("cycle", func(t *testing.T) {
+		a := &linkedlist.Node{Value: 1}
+		b := &linkedlist.Node{Value: 2}
+		c := &linkedlist.Node{Value: 3}
+		a.LinkNext(b)
+		b.LinkNext(c)
+		c.LinkNext(a)
+		b.LinkPrevious(a)
+		c.LinkPrevious(b)
+		list := newList()
+		list.Head = a
+		list.Tail = c
+		err := assertValidationError(t, list)
+		ok(t, err.Has(linkedlist.Cycle))
+		ok(t, err.Has(linkedlist.TailHasNext))
+	})
+}
+
+func assertValidationError(t *testing.T, list linkedlist.LinkedList) *linkedlist.ValidationError {
+	var verr *linkedlist.ValidationError
+	if err := list.Validate(); !errors.As(err, &verr) {
+		t.Fatal("expected a validation error, got: ", err)
+	}
+	return verr
+}
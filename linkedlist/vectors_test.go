@@ -0,0 +1,77 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/internal/listtest"
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+func TestVectors(t *testing.T) {
+	for _, v := range listtest.Vectors {
+		t.Run(v.Name, func(t *testing.T) {
+			list := linkedlist.NewOf[int]()
+			for _, value := range v.Initial {
+				list.Add(&linkedlist.NodeOf[int]{Value: value})
+			}
+			result := applyVector(&list, v)
+			if result != v.Result {
+				t.Fatal("result is unexpected - got: ", result, " expected: ", v.Result)
+			}
+			got := []int{}
+			for value := range list.Values() {
+				got = append(got, value)
+			}
+			assertSlicesAreEqual(t, v.Expected, got)
+			ok(t, list.Validate() == nil)
+		})
+	}
+}
+
+func applyVector(list *linkedlist.LinkedListOf[int], v listtest.Vector) int {
+	equals := func(value int) linkedlist.MatcherFnOf[int] {
+		return func(node *linkedlist.NodeOf[int]) bool {
+			return node.Value == value
+		}
+	}
+	valueOf := func(node *linkedlist.NodeOf[int]) int {
+		if node == nil {
+			return -1
+		}
+		return node.Value
+	}
+	boolResult := func(b bool) int {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	switch v.Op {
+	case listtest.Add:
+		list.Add(&linkedlist.NodeOf[int]{Value: v.Value})
+	case listtest.AddToStart:
+		list.AddToStart(&linkedlist.NodeOf[int]{Value: v.Value})
+	case listtest.RemoveHead:
+		return valueOf(list.RemoveHead())
+	case listtest.RemoveTail:
+		return valueOf(list.RemoveTail())
+	case listtest.RemoveFirstOccurrence:
+		return boolResult(list.RemoveFirstOccurrence(equals(v.Value)))
+	case listtest.RemoveLastOccurrence:
+		return boolResult(list.RemoveLastOccurrence(equals(v.Value)))
+	case listtest.InsertBefore:
+		list.InsertBefore(equals(v.Index), &linkedlist.NodeOf[int]{Value: v.Value})
+	case listtest.InsertAfter:
+		list.InsertAfter(equals(v.Index), &linkedlist.NodeOf[int]{Value: v.Value})
+	case listtest.Get:
+		_, node := list.Get(v.Index)
+		return valueOf(node)
+	case listtest.Set:
+		return boolResult(list.Set(v.Index, &linkedlist.NodeOf[int]{Value: v.Value}))
+	case listtest.IndexOf:
+		return list.IndexOf(equals(v.Value))
+	case listtest.LastIndexOf:
+		return list.LastIndexOf(equals(v.Value))
+	}
+	return 0
+}
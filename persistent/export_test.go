@@ -0,0 +1,7 @@
+package persistent
+
+// SameCells reports whether a and b start at the same cell, meaning one
+// shares its structure with the other
+func SameCells[T any](a, b List[T]) bool {
+	return a.head == b.head
+}
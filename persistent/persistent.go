@@ -0,0 +1,171 @@
+// Package persistent provides an immutable singly linked list. Every
+// operation returns a new version of the list that shares as many cells as
+// possible with the one it was derived from, so old versions stay valid and
+// lists can be read from several goroutines without locking.
+package persistent
+
+import (
+	"iter"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
+
+// List is an immutable list of values of type T. The zero value is an empty
+// list.
+type List[T any] struct {
+	head *cell[T]
+	size int
+}
+
+// cell is never modified after it has been linked into a list
+type cell[T any] struct {
+	value T
+	next  *cell[T]
+}
+
+// New returns a list holding values in order
+func New[T any](values ...T) List[T] {
+	var l List[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		l = l.Prepend(values[i])
+	}
+	return l
+}
+
+// FromLinkedList returns a list holding the values of the nodes in list
+func FromLinkedList[T any](list *linkedlist.LinkedListOf[T]) List[T] {
+	var l List[T]
+	for node := list.Tail; node != nil; node = node.Previous() {
+		l = l.Prepend(node.Value)
+	}
+	return l
+}
+
+// ToLinkedList returns a new linked list holding the values of l
+func (l List[T]) ToLinkedList() linkedlist.LinkedListOf[T] {
+	list := linkedlist.NewOf[T]()
+	for v := range l.All() {
+		list.Add(&linkedlist.NodeOf[T]{Value: v})
+	}
+	return list
+}
+
+// Size returns the total number of values in the list
+func (l List[T]) Size() int {
+	return l.size
+}
+
+// IsEmpty reports whether the list holds no values
+func (l List[T]) IsEmpty() bool {
+	return l.head == nil
+}
+
+// Head returns the first value in the list
+func (l List[T]) Head() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+	return l.head.value, true
+}
+
+// Get returns the value at the specified index
+func (l List[T]) Get(index int) (bool, T) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return false, zero
+	}
+	return true, l.Drop(index).head.value
+}
+
+// Prepend returns a list with value in front of the values of l, sharing
+// all of l
+func (l List[T]) Prepend(value T) List[T] {
+	return List[T]{head: &cell[T]{value: value, next: l.head}, size: l.size + 1}
+}
+
+// Tail returns the list without its first value, sharing all of it
+func (l List[T]) Tail() List[T] {
+	return l.Drop(1)
+}
+
+// Drop returns the list without its first n values, sharing all of it
+func (l List[T]) Drop(n int) List[T] {
+	if n >= l.size {
+		return List[T]{}
+	}
+	c := l.head
+	for i := 0; i < n; i++ {
+		c = c.next
+	}
+	return List[T]{head: c, size: l.size - max(n, 0)}
+}
+
+// Take returns a list of the first n values of l. Only the cells before the
+// cut are copied.
+func (l List[T]) Take(n int) List[T] {
+	if n >= l.size {
+		return l
+	}
+	if n <= 0 {
+		return List[T]{}
+	}
+	return l.copyFront(n, nil)
+}
+
+// Reverse returns a list of the values of l in reverse order
+func (l List[T]) Reverse() List[T] {
+	var r List[T]
+	for c := l.head; c != nil; c = c.next {
+		r = r.Prepend(c.value)
+	}
+	return r
+}
+
+// Concat returns a list of the values of l followed by the values of other.
+// The cells of l are copied and all of other is shared.
+func (l List[T]) Concat(other List[T]) List[T] {
+	if l.head == nil {
+		return other
+	}
+	if other.head == nil {
+		return l
+	}
+	r := l.copyFront(l.size, other.head)
+	r.size += other.size
+	return r
+}
+
+// copyFront copies the first n cells of l and links the last copy to rest
+func (l List[T]) copyFront(n int, rest *cell[T]) List[T] {
+	head := &cell[T]{value: l.head.value}
+	last := head
+	c := l.head.next
+	for i := 1; i < n; i++ {
+		last.next = &cell[T]{value: c.value}
+		last = last.next
+		c = c.next
+	}
+	last.next = rest
+	return List[T]{head: head, size: n}
+}
+
+// ToSlice returns a slice of the values in the list
+func (l List[T]) ToSlice() []T {
+	values := make([]T, 0, l.size)
+	for c := l.head; c != nil; c = c.next {
+		values = append(values, c.value)
+	}
+	return values
+}
+
+// All returns a sequence of the values in the list
+func (l List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for c := l.head; c != nil; c = c.next {
+			if !yield(c.value) {
+				return
+			}
+		}
+	}
+}
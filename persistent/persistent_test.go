@@ -0,0 +1,200 @@
+package persistent_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+	"github.com/miketmoore/data-structures-go/persistent"
+)
+
+func TestNew(t *testing.T) {
+	var zero persistent.List[int]
+	ok(t, zero.IsEmpty())
+	assertListValues(t, zero)
+	_, found := zero.Head()
+	ok(t, !found)
+
+	list := persistent.New(1, 2, 3)
+	ok(t, !list.IsEmpty())
+	assertListValues(t, list, 1, 2, 3)
+	v, found := list.Head()
+	ok(t, found && v == 1)
+}
+
+func TestGet(t *testing.T) {
+	list := persistent.New(1, 2, 3)
+	for i := 0; i < 3; i++ {
+		found, v := list.Get(i)
+		ok(t, found && v == i+1)
+	}
+	found, _ := list.Get(3)
+	ok(t, !found)
+	found, _ = list.Get(-1)
+	ok(t, !found)
+}
+
+func TestPrependShares(t *testing.T) {
+	list := persistent.New(2, 3)
+	a := list.Prepend(1)
+	b := list.Prepend(10)
+	assertListValues(t, list, 2, 3)
+	assertListValues(t, a, 1, 2, 3)
+	assertListValues(t, b, 10, 2, 3)
+	ok(t, persistent.SameCells(a.Tail(), list))
+	ok(t, persistent.SameCells(b.Tail(), list))
+}
+
+func TestTailDrop(t *testing.T) {
+	list := persistent.New(1, 2, 3, 4)
+	assertListValues(t, list.Tail(), 2, 3, 4)
+	assertListValues(t, list.Drop(2), 3, 4)
+	assertListValues(t, list.Drop(0), 1, 2, 3, 4)
+	assertListValues(t, list.Drop(-1), 1, 2, 3, 4)
+	assertListValues(t, list.Drop(4))
+	assertListValues(t, list.Drop(9))
+	assertListValues(t, persistent.List[int]{}.Tail())
+	ok(t, persistent.SameCells(list.Drop(2), list.Tail().Tail()))
+	assertListValues(t, list, 1, 2, 3, 4)
+}
+
+func TestTake(t *testing.T) {
+	list := persistent.New(1, 2, 3, 4)
+	assertListValues(t, list.Take(2), 1, 2)
+	assertListValues(t, list.Take(0))
+	assertListValues(t, list.Take(-1))
+	assertListValues(t, list.Take(4), 1, 2, 3, 4)
+	ok(t, persistent.SameCells(list.Take(9), list))
+
+	front := list.Take(2)
+	assertListValues(t, front.Prepend(0), 0, 1, 2)
+	assertListValues(t, list, 1, 2, 3, 4)
+}
+
+func TestReverse(t *testing.T) {
+	list := persistent.New(1, 2, 3)
+	assertListValues(t, list.Reverse(), 3, 2, 1)
+	assertListValues(t, list, 1, 2, 3)
+	assertListValues(t, persistent.List[int]{}.Reverse())
+}
+
+func TestConcat(t *testing.T) {
+	a := persistent.New(1, 2)
+	b := persistent.New(3, 4)
+	c := a.Concat(b)
+	assertListValues(t, c, 1, 2, 3, 4)
+	assertListValues(t, a, 1, 2)
+	assertListValues(t, b, 3, 4)
+	ok(t, persistent.SameCells(c.Drop(2), b))
+
+	var empty persistent.List[int]
+	ok(t, persistent.SameCells(empty.Concat(b), b))
+	ok(t, persistent.SameCells(a.Concat(empty), a))
+}
+
+func TestLinkedList(t *testing.T) {
+	list := linkedlist.NewOf[int]()
+	for i := 1; i <= 3; i++ {
+		list.Add(&linkedlist.NodeOf[int]{Value: i})
+	}
+	p := persistent.FromLinkedList(&list)
+	assertListValues(t, p, 1, 2, 3)
+
+	list.RemoveHead()
+	assertListValues(t, p, 1, 2, 3)
+
+	back := p.Prepend(0).ToLinkedList()
+	ok(t, back.Size() == 4)
+	i := 0
+	for v := range back.Values() {
+		ok(t, v == i)
+		i++
+	}
+
+	untyped := linkedlist.New()
+	untyped.Add(&linkedlist.Node{Value: "a"})
+	assertSize(t, persistent.FromLinkedList(&untyped), 1)
+}
+
+func TestConcurrentReaders(t *testing.T) {
+	list := persistent.New[int]()
+	for i := 0; i < 1000; i++ {
+		list = list.Prepend(i)
+	}
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			version := list.Drop(g).Prepend(-g)
+			if v, _ := version.Head(); v != -g {
+				t.Error("head is unexpected")
+			}
+			if len(version.ToSlice()) != 1000-g+1 {
+				t.Error("size is unexpected")
+			}
+		}(g)
+	}
+	wg.Wait()
+	assertSize(t, list, 1000)
+}
+
+func BenchmarkPrependShare(b *testing.B) {
+	list := persistent.New[int]()
+	for i := 0; i < benchSize; i++ {
+		list = list.Prepend(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Prepend(i)
+	}
+}
+
+func BenchmarkLinkedListCopy(b *testing.B) {
+	list := linkedlist.NewOf[int]()
+	for i := 0; i < benchSize; i++ {
+		list.Add(&linkedlist.NodeOf[int]{Value: i})
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c := list.Copy(func(node *linkedlist.NodeOf[int]) *linkedlist.NodeOf[int] {
+			return &linkedlist.NodeOf[int]{Value: node.Value}
+		})
+		c.AddToStart(&linkedlist.NodeOf[int]{Value: i})
+	}
+}
+
+const benchSize = 10000
+
+func assertSize[T any](t *testing.T, list persistent.List[T], expected int) {
+	if list.Size() != expected {
+		t.Fatal("list size is unexpected - got: ", list.Size(), " expected: ", expected)
+	}
+}
+
+func assertListValues(t *testing.T, list persistent.List[int], expected ...int) {
+	assertSize(t, list, len(expected))
+	assertSlicesAreEqual(t, expected, list.ToSlice())
+	i := 0
+	for v := range list.All() {
+		ok(t, v == expected[i])
+		i++
+	}
+}
+
+func ok(t *testing.T, b bool) {
+	if b == false {
+		t.Fatal("not ok")
+	}
+}
+
+func assertSlicesAreEqual(t *testing.T, expected, got []int) {
+	if len(expected) != len(got) {
+		t.Fatal("slices are not of equal length")
+	}
+	for i := 0; i < len(expected); i++ {
+		if expected[i] != got[i] {
+			t.Fatal("slice values are not equal")
+		}
+	}
+}
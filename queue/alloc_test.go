@@ -0,0 +1,28 @@
+//go:build !linkedlistdebug
+
+// Validating after every mutation allocates, so the allocation tests only
+// run in normal builds.
+
+package queue_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/queue"
+)
+
+func TestEnqueueValueDoesNotAllocate(t *testing.T) {
+	q := queue.New()
+	var msg interface{} = "message"
+	for i := 0; i < 100; i++ {
+		q.EnqueueValue(msg)
+	}
+	for i := 0; i < 100; i++ {
+		q.DequeueValue()
+	}
+	allocs := testing.AllocsPerRun(1000, func() {
+		q.EnqueueValue(msg)
+		q.DequeueValue()
+	})
+	ok(t, allocs == 0)
+}
@@ -1,6 +1,8 @@
 package queue
 
 import (
+	"iter"
+
 	"github.com/miketmoore/data-structures-go/linkedlist"
 )
 
@@ -24,12 +26,48 @@ func (q *Queue) Dequeue() *linkedlist.Node {
 	return q.list.RemoveHead()
 }
 
+// EnqueueValue adds a value to the end of the queue, reusing a node
+// released by DequeueValue when one is available
+func (q *Queue) EnqueueValue(value interface{}) {
+	q.list.AddValue(value)
+}
+
+// DequeueValue removes the first value from the queue. The node that held it
+// is kept for reuse, so it must not be retained.
+func (q *Queue) DequeueValue() (interface{}, bool) {
+	return q.list.RemoveHeadValue()
+}
+
 // Peek returns but does not remove the first node (head) in the list
 func (q *Queue) Peek() *linkedlist.Node {
 	return q.list.Tail
 }
 
+// ReleasePool drops the nodes kept for reuse by the value methods so they
+// can be collected
+func (q *Queue) ReleasePool() {
+	q.list.ReleasePool()
+}
+
 // IsEmpty indicates if the list is empty or not
 func (q *Queue) IsEmpty() bool {
 	return q.list.Size() == 0
 }
+
+// All returns a sequence of the nodes from the front of the queue to the
+// back without removing them
+func (q *Queue) All() iter.Seq[*linkedlist.Node] {
+	return q.list.All()
+}
+
+// Drain returns a sequence that dequeues each node as it is visited.
+// Stopping early leaves the remaining nodes in the queue.
+func (q *Queue) Drain() iter.Seq[*linkedlist.Node] {
+	return func(yield func(*linkedlist.Node) bool) {
+		for !q.IsEmpty() {
+			if !yield(q.Dequeue()) {
+				return
+			}
+		}
+	}
+}
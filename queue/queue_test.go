@@ -1,6 +1,7 @@
 package queue_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/miketmoore/data-structures-go/linkedlist"
@@ -63,6 +64,102 @@ func TestIntegrationNames(t *testing.T) {
 	ok(t, q.Dequeue().Value == "Penny")
 }
 
+func TestAll(t *testing.T) {
+	q := queue.New()
+	q.Enqueue(&linkedlist.Node{Value: 1})
+	q.Enqueue(&linkedlist.Node{Value: 2})
+	q.Enqueue(&linkedlist.Node{Value: 3})
+
+	expected := []int{1, 2, 3}
+	i := 0
+	for node := range q.All() {
+		ok(t, node.Value == expected[i])
+		i++
+	}
+	ok(t, i == 3)
+	ok(t, !q.IsEmpty())
+}
+
+func TestDrain(t *testing.T) {
+	q := queue.New()
+	q.Enqueue(&linkedlist.Node{Value: 1})
+	q.Enqueue(&linkedlist.Node{Value: 2})
+	q.Enqueue(&linkedlist.Node{Value: 3})
+
+	for node := range q.Drain() {
+		if node.Value == 2 {
+			break
+		}
+	}
+	ok(t, q.Dequeue().Value == 3)
+	ok(t, q.IsEmpty())
+}
+
+func TestEnqueueValue(t *testing.T) {
+	q := queue.New()
+	q.EnqueueValue("a")
+	q.EnqueueValue("b")
+	v, found := q.DequeueValue()
+	ok(t, found && v == "a")
+	q.EnqueueValue("c")
+	v, found = q.DequeueValue()
+	ok(t, found && v == "b")
+	v, found = q.DequeueValue()
+	ok(t, found && v == "c")
+	_, found = q.DequeueValue()
+	ok(t, !found)
+	ok(t, q.IsEmpty())
+}
+
+func TestDequeueValueKeepsCallerNodes(t *testing.T) {
+	q := queue.New()
+	node := &linkedlist.Node{Value: 1}
+	q.Enqueue(node)
+	v, found := q.DequeueValue()
+	ok(t, found && v == 1)
+	q.EnqueueValue(9)
+	ok(t, node.Value == 1)
+	ok(t, q.Dequeue() != node)
+
+	q.ReleasePool()
+	q.EnqueueValue(2)
+	v, found = q.DequeueValue()
+	ok(t, found && v == 2)
+}
+
+// BenchmarkEnqueue enqueues n items per iteration. If Enqueue is constant time
+// the ns/op figures grow linearly with n.
+func BenchmarkEnqueue(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000, 1000000} {
+		b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				q := queue.New()
+				for j := 0; j < n; j++ {
+					q.Enqueue(&linkedlist.Node{Value: j})
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkEnqueueDequeue(b *testing.B) {
+	q := queue.New()
+	for i := 0; i < b.N; i++ {
+		q.Enqueue(&linkedlist.Node{Value: i})
+		q.Dequeue()
+	}
+}
+
+func BenchmarkEnqueueDequeueValue(b *testing.B) {
+	b.ReportAllocs()
+	q := queue.New()
+	var msg interface{} = "message"
+	for i := 0; i < b.N; i++ {
+		q.EnqueueValue(msg)
+		q.DequeueValue()
+	}
+}
+
 func ok(t *testing.T, v bool) {
 	if v == false {
 		t.Fatal("not ok")
@@ -1,5 +1,7 @@
 package set
 
+import "iter"
+
 // SetInt maintains a unique collection of int values
 type SetInt struct {
 	data map[int]bool
@@ -41,6 +43,17 @@ func (s *SetInt) IterateInt(cb func(int)) {
 	}
 }
 
+// Values returns a sequence of the values in the set, in no particular order
+func (s *SetInt) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for key := range s.data {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
 // SubsetInt tests if the specified set is a subset
 func (s *SetInt) SubsetInt(b SetInt) bool {
 	if s.Size() == 0 || b.Size() == 0 {
@@ -164,6 +164,26 @@ func TestDifferenceInt(t *testing.T) {
 	ok(t, c.HasInt(4))
 }
 
+func TestValues(t *testing.T) {
+	s := set.NewInt()
+	s.AddInt(1)
+	s.AddInt(2)
+	s.AddInt(3)
+
+	sum := 0
+	for v := range s.Values() {
+		sum += v
+	}
+	ok(t, sum == 6)
+
+	count := 0
+	for range s.Values() {
+		count++
+		break
+	}
+	ok(t, count == 1)
+}
+
 func ok(t *testing.T, v bool) {
 	if v == false {
 		t.Fatal("not ok")
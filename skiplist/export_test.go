@@ -0,0 +1,13 @@
+package skiplist
+
+import "fmt"
+
+// Levels describes the height of every node, so tests can compare the shape
+// of two skip lists
+func Levels[K, V any](s *SkipList[K, V]) string {
+	heights := []int{}
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		heights = append(heights, len(x.next))
+	}
+	return fmt.Sprint(heights)
+}
@@ -0,0 +1,307 @@
+// Package skiplist provides an ordered map built on a skip list. Nodes are
+// linked in several levels, each level skipping over more of the list than
+// the one below it, which gives O(log n) expected time for lookups, updates
+// and rank queries.
+package skiplist
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+	"math/rand"
+)
+
+const (
+	// maxLevel caps the number of levels, enough for 4^32 entries
+	maxLevel = 32
+	// p is the chance of a node being promoted to the next level up
+	p = 0.25
+)
+
+// ErrNoComparator is the panic value used when entries are put into a skip
+// list that was not created by New or NewOrdered
+var ErrNoComparator = errors.New("skiplist: skip list has no comparator, create it with New or NewOrdered")
+
+// SkipList is an ordered map from keys of type K to values of type V.
+// Create one with New or NewOrdered, which supply the key order. The zero
+// value reads as an empty map, but Put panics with ErrNoComparator.
+type SkipList[K, V any] struct {
+	head    *node[K, V]
+	level   int
+	size    int
+	compare func(a, b K) int
+	rand    *rand.Rand
+}
+
+type node[K, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+	// span[i] counts how many nodes on the bottom level next[i] skips over,
+	// including the node it lands on, which makes rank queries possible
+	span []int
+}
+
+// Option configures a SkipList
+type Option func(*options)
+
+type options struct {
+	seed    int64
+	hasSeed bool
+}
+
+// WithSeed makes the random choice of node levels deterministic, so that
+// tests are reproducible
+func WithSeed(seed int64) Option {
+	return func(o *options) {
+		o.seed = seed
+		o.hasSeed = true
+	}
+}
+
+// New returns an empty skip list ordered by compare, which returns a
+// negative number when a < b, zero when a == b and a positive number when
+// a > b
+func New[K, V any](compare func(a, b K) int, opts ...Option) SkipList[K, V] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if !o.hasSeed {
+		o.seed = rand.Int63()
+	}
+	return SkipList[K, V]{
+		head:    &node[K, V]{next: make([]*node[K, V], maxLevel), span: make([]int, maxLevel)},
+		level:   1,
+		compare: compare,
+		rand:    rand.New(rand.NewSource(o.seed)),
+	}
+}
+
+// NewOrdered returns an empty skip list ordered by the natural order of K
+func NewOrdered[K cmp.Ordered, V any](opts ...Option) SkipList[K, V] {
+	return New[K, V](cmp.Compare[K], opts...)
+}
+
+// Size returns the total number of entries in the skip list
+func (s *SkipList[K, V]) Size() int {
+	return s.size
+}
+
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < maxLevel && s.rand.Float64() < p {
+		level++
+	}
+	return level
+}
+
+// Get returns the value stored for key
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	if s.head == nil {
+		var zero V
+		return zero, false
+	}
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.compare(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+	}
+	x = x.next[0]
+	if x != nil && s.compare(x.key, key) == 0 {
+		return x.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key, replacing any value already stored. It returns
+// true if the key was already present.
+func (s *SkipList[K, V]) Put(key K, value V) bool {
+	if s.compare == nil {
+		panic(ErrNoComparator)
+	}
+	var update [maxLevel]*node[K, V]
+	var rank [maxLevel]int
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && s.compare(x.next[i].key, key) < 0 {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	if next := x.next[0]; next != nil && s.compare(next.key, key) == 0 {
+		next.value = value
+		return true
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			s.head.span[i] = s.size
+		}
+		s.level = level
+	}
+	x = &node[K, V]{key: key, value: value, next: make([]*node[K, V], level), span: make([]int, level)}
+	for i := 0; i < level; i++ {
+		x.next[i] = update[i].next[i]
+		update[i].next[i] = x
+		x.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+	s.size++
+	return false
+}
+
+// Delete removes the entry for key. It returns false if the key was not
+// present.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	if s.head == nil {
+		return false
+	}
+	var update [maxLevel]*node[K, V]
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.compare(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	x = x.next[0]
+	if x == nil || s.compare(x.key, key) != 0 {
+		return false
+	}
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == x {
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+	return true
+}
+
+// Floor returns the entry with the greatest key less than or equal to key
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	if s.head == nil {
+		return s.none()
+	}
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.compare(x.next[i].key, key) <= 0 {
+			x = x.next[i]
+		}
+	}
+	if x == s.head {
+		return s.none()
+	}
+	return x.key, x.value, true
+}
+
+// Ceiling returns the entry with the least key greater than or equal to key
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	x := s.ceiling(key)
+	if x == nil {
+		return s.none()
+	}
+	return x.key, x.value, true
+}
+
+func (s *SkipList[K, V]) ceiling(key K) *node[K, V] {
+	if s.head == nil {
+		return nil
+	}
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.compare(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+	}
+	return x.next[0]
+}
+
+func (s *SkipList[K, V]) none() (K, V, bool) {
+	var key K
+	var value V
+	return key, value, false
+}
+
+// At returns the entry at the specified index in key order
+func (s *SkipList[K, V]) At(index int) (K, V, bool) {
+	if index < 0 || index >= s.size {
+		return s.none()
+	}
+	target := index + 1
+	traversed := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= target {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+		if traversed == target {
+			break
+		}
+	}
+	return x.key, x.value, true
+}
+
+// Rank returns the index of key in key order, or -1 if it is not present
+func (s *SkipList[K, V]) Rank(key K) int {
+	if s.head == nil {
+		return -1
+	}
+	rank := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.compare(x.next[i].key, key) <= 0 {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+		if x != s.head && s.compare(x.key, key) == 0 {
+			return rank - 1
+		}
+	}
+	return -1
+}
+
+// All returns a sequence of the entries in key order
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if s.head == nil {
+			return
+		}
+		for x := s.head.next[0]; x != nil; x = x.next[0] {
+			if !yield(x.key, x.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns a sequence, in key order, of the entries with keys from lo
+// to hi inclusive
+func (s *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := s.ceiling(lo); x != nil && s.compare(x.key, hi) <= 0; x = x.next[0] {
+			if !yield(x.key, x.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package skiplist_test
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+	"github.com/miketmoore/data-structures-go/skiplist"
+)
+
+func TestPutGet(t *testing.T) {
+	list := skiplist.NewOrdered[int, string](skiplist.WithSeed(1))
+	ok(t, list.Size() == 0)
+	_, found := list.Get(1)
+	ok(t, !found)
+
+	ok(t, !list.Put(2, "b"))
+	ok(t, !list.Put(1, "a"))
+	ok(t, !list.Put(3, "c"))
+	ok(t, list.Put(2, "B"))
+	ok(t, list.Size() == 3)
+
+	v, found := list.Get(2)
+	ok(t, found && v == "B")
+	_, found = list.Get(4)
+	ok(t, !found)
+	for i, expected := range []int{1, 2, 3} {
+		k, _, _ := list.At(i)
+		ok(t, k == expected)
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var list skiplist.SkipList[int, int]
+	ok(t, list.Size() == 0)
+	_, found := list.Get(1)
+	ok(t, !found)
+	ok(t, !list.Delete(1))
+	_, _, found = list.Floor(1)
+	ok(t, !found)
+	_, _, found = list.Ceiling(1)
+	ok(t, !found)
+	_, _, found = list.At(0)
+	ok(t, !found)
+	ok(t, list.Rank(1) == -1)
+	for range list.All() {
+		t.Fatal("zero value yielded an entry")
+	}
+	for range list.Range(0, 10) {
+		t.Fatal("zero value yielded an entry")
+	}
+
+	defer func() {
+		if r := recover(); r != skiplist.ErrNoComparator {
+			t.Fatal("unexpected panic - got: ", r, " expected: ", skiplist.ErrNoComparator)
+		}
+	}()
+	list.Put(1, 1)
+}
+
+func TestDelete(t *testing.T) {
+	list := newList(1, 2, 3, 4, 5)
+	ok(t, list.Delete(3))
+	ok(t, !list.Delete(3))
+	ok(t, list.Delete(1))
+	ok(t, list.Delete(5))
+	assertKeys(t, list, 2, 4)
+	ok(t, list.Delete(2))
+	ok(t, list.Delete(4))
+	assertKeys(t, list)
+}
+
+func TestFloorCeiling(t *testing.T) {
+	list := newList(10, 20, 30)
+
+	k, _, found := list.Floor(20)
+	ok(t, found && k == 20)
+	k, _, found = list.Floor(25)
+	ok(t, found && k == 20)
+	k, _, found = list.Floor(99)
+	ok(t, found && k == 30)
+	_, _, found = list.Floor(9)
+	ok(t, !found)
+
+	k, _, found = list.Ceiling(20)
+	ok(t, found && k == 20)
+	k, _, found = list.Ceiling(25)
+	ok(t, found && k == 30)
+	k, _, found = list.Ceiling(0)
+	ok(t, found && k == 10)
+	_, _, found = list.Ceiling(31)
+	ok(t, !found)
+}
+
+func TestAtRank(t *testing.T) {
+	list := newList(50, 10, 40, 20, 30)
+	for i, expected := range []int{10, 20, 30, 40, 50} {
+		k, v, found := list.At(i)
+		ok(t, found && k == expected && v == expected*10)
+		ok(t, list.Rank(expected) == i)
+	}
+	_, _, found := list.At(5)
+	ok(t, !found)
+	_, _, found = list.At(-1)
+	ok(t, !found)
+	ok(t, list.Rank(25) == -1)
+}
+
+func TestRange(t *testing.T) {
+	list := newList(1, 3, 5, 7, 9)
+	keys := []int{}
+	for k := range list.Range(3, 7) {
+		keys = append(keys, k)
+	}
+	assertSlicesAreEqual(t, []int{3, 5, 7}, keys)
+
+	keys = []int{}
+	for k := range list.Range(2, 8) {
+		if k > 5 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	assertSlicesAreEqual(t, []int{3, 5}, keys)
+
+	for range list.Range(10, 20) {
+		t.Fatal("range past the end should be empty")
+	}
+}
+
+func TestComparator(t *testing.T) {
+	list := skiplist.New[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(b), strings.ToLower(a))
+	})
+	list.Put("apple", 1)
+	list.Put("Cherry", 3)
+	list.Put("banana", 2)
+	ok(t, list.Put("APPLE", 4))
+
+	v, found := list.Get("Apple")
+	ok(t, found && v == 4)
+	keys := []string{}
+	for k := range list.All() {
+		keys = append(keys, k)
+	}
+	ok(t, slices.Equal(keys, []string{"Cherry", "banana", "apple"}))
+}
+
+func TestSeedIsDeterministic(t *testing.T) {
+	build := func() skiplist.SkipList[int, int] {
+		list := skiplist.NewOrdered[int, int](skiplist.WithSeed(42))
+		for i := 0; i < 1000; i++ {
+			list.Put(i, i)
+		}
+		return list
+	}
+	a, b := build(), build()
+	ok(t, skiplist.Levels(&a) == skiplist.Levels(&b))
+}
+
+func TestAgainstMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	list := skiplist.NewOrdered[int, int](skiplist.WithSeed(1))
+	model := map[int]int{}
+	for i := 0; i < 5000; i++ {
+		key := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, present := model[key]
+			ok(t, list.Delete(key) == present)
+			delete(model, key)
+		} else {
+			_, present := model[key]
+			ok(t, list.Put(key, i) == present)
+			model[key] = i
+		}
+		if i%250 == 0 {
+			assertMatchesModel(t, list, model)
+		}
+	}
+	assertMatchesModel(t, list, model)
+}
+
+func BenchmarkGetSkipList(b *testing.B) {
+	list := skiplist.NewOrdered[int, int](skiplist.WithSeed(1))
+	for i := 0; i < benchSize; i++ {
+		list.Put(i, i)
+	}
+	r := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Get(r.Intn(benchSize))
+	}
+}
+
+func BenchmarkFindLinkedList(b *testing.B) {
+	list := linkedlist.NewOf[int]()
+	for i := 0; i < benchSize; i++ {
+		list.Add(&linkedlist.NodeOf[int]{Value: i})
+	}
+	r := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		key := r.Intn(benchSize)
+		list.Find(func(node *linkedlist.NodeOf[int]) bool {
+			return node.Value == key
+		})
+	}
+}
+
+const benchSize = 10000
+
+func newList(keys ...int) skiplist.SkipList[int, int] {
+	list := skiplist.NewOrdered[int, int](skiplist.WithSeed(1))
+	for _, k := range keys {
+		list.Put(k, k*10)
+	}
+	return list
+}
+
+func assertMatchesModel(t *testing.T, list skiplist.SkipList[int, int], model map[int]int) {
+	keys := []int{}
+	for k := range model {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	assertKeys(t, list, keys...)
+	for i, k := range keys {
+		v, found := list.Get(k)
+		ok(t, found && v == model[k])
+		ok(t, list.Rank(k) == i)
+	}
+}
+
+func assertKeys(t *testing.T, list skiplist.SkipList[int, int], expected ...int) {
+	if list.Size() != len(expected) {
+		t.Fatal("list size is unexpected - got: ", list.Size(), " expected: ", len(expected))
+	}
+	keys := []int{}
+	for k := range list.All() {
+		keys = append(keys, k)
+	}
+	assertSlicesAreEqual(t, expected, keys)
+	for i := range expected {
+		if k, _, _ := list.At(i); k != expected[i] {
+			t.Fatal("At is unexpected - index: ", i, " got: ", k, " expected: ", expected[i])
+		}
+	}
+}
+
+func ok(t *testing.T, b bool) {
+	if b == false {
+		t.Fatal("not ok")
+	}
+}
+
+func assertSlicesAreEqual(t *testing.T, expected, got []int) {
+	if len(expected) != len(got) {
+		t.Fatal("slices are not of equal length")
+	}
+	for i := 0; i < len(expected); i++ {
+		if expected[i] != got[i] {
+			t.Fatal("slice values are not equal")
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package slist
+
+import "iter"
+
+// LinkedListOf represents a singly linked list holding values of type T.
+// Nodes only link forwards, so operations that walk backwards are not
+// available and removing the tail takes linear time.
+type LinkedListOf[T any] struct {
+	Head *NodeOf[T]
+	Tail *NodeOf[T]
+	size int
+}
+
+// NodeOf represents one link in a singly linked list holding values of type T
+type NodeOf[T any] struct {
+	Value T
+	next  *NodeOf[T]
+}
+
+// MatcherFnOf represents a matcher for nodes holding values of type T
+type MatcherFnOf[T any] func(*NodeOf[T]) bool
+
+// LinkedList is a singly linked list holding values of any type
+type LinkedList = LinkedListOf[interface{}]
+
+// Node is one link in a LinkedList
+type Node = NodeOf[interface{}]
+
+// MatcherFn represents a matcher
+type MatcherFn = func(*Node) bool
+
+// Next returns the next node, if it exists
+func (n *NodeOf[T]) Next() *NodeOf[T] {
+	return n.next
+}
+
+// New returns an empty linked list
+func New() LinkedList {
+	return LinkedList{}
+}
+
+// NewOf returns an empty linked list holding values of type T
+func NewOf[T any]() LinkedListOf[T] {
+	return LinkedListOf[T]{}
+}
+
+// linkAfter links a node after mark. A nil mark links the node at the start
+// of the list.
+func (l *LinkedListOf[T]) linkAfter(node, mark *NodeOf[T]) {
+	if mark == nil {
+		node.next = l.Head
+		l.Head = node
+	} else {
+		node.next = mark.next
+		mark.next = node
+	}
+	if node.next == nil {
+		l.Tail = node
+	}
+	l.size++
+}
+
+// unlinkAfter removes the node after mark. A nil mark removes the head.
+func (l *LinkedListOf[T]) unlinkAfter(mark *NodeOf[T]) *NodeOf[T] {
+	var node *NodeOf[T]
+	if mark == nil {
+		node = l.Head
+		l.Head = node.next
+	} else {
+		node = mark.next
+		mark.next = node.next
+	}
+	if node.next == nil {
+		l.Tail = mark
+	}
+	node.next = nil
+	l.size--
+	return node
+}
+
+// find returns the first matching node and the node before it
+func (l *LinkedListOf[T]) find(matcher MatcherFnOf[T]) (previous, node *NodeOf[T]) {
+	for node = l.Head; node != nil; previous, node = node, node.next {
+		if matcher(node) {
+			return previous, node
+		}
+	}
+	return nil, nil
+}
+
+// Add appends a node to the end of the list
+func (l *LinkedListOf[T]) Add(node *NodeOf[T]) {
+	l.linkAfter(node, l.Tail)
+}
+
+// AddToStart adds a node to the beginning of the list
+func (l *LinkedListOf[T]) AddToStart(node *NodeOf[T]) {
+	l.linkAfter(node, nil)
+}
+
+// AddAll appends items to the end of the list
+func (l *LinkedListOf[T]) AddAll(all []*NodeOf[T]) {
+	for i := 0; i < len(all); i++ {
+		l.Add(all[i])
+	}
+}
+
+// RemoveHead removes the first node from the list
+func (l *LinkedListOf[T]) RemoveHead() *NodeOf[T] {
+	if l.Head == nil {
+		return nil
+	}
+	return l.unlinkAfter(nil)
+}
+
+// RemoveTail removes the last node from the list. It has to walk the whole
+// list to find the node before the tail.
+func (l *LinkedListOf[T]) RemoveTail() *NodeOf[T] {
+	if l.Head == nil {
+		return nil
+	}
+	var previous *NodeOf[T]
+	for node := l.Head; node != l.Tail; node = node.next {
+		previous = node
+	}
+	return l.unlinkAfter(previous)
+}
+
+// RemoveFirstOccurrence removes the first occurence of the value in the list
+func (l *LinkedListOf[T]) RemoveFirstOccurrence(matcher MatcherFnOf[T]) bool {
+	previous, node := l.find(matcher)
+	if node == nil {
+		return false
+	}
+	l.unlinkAfter(previous)
+	return true
+}
+
+// RemoveLastOccurrence removes the last occurence of the value in the list
+func (l *LinkedListOf[T]) RemoveLastOccurrence(matcher MatcherFnOf[T]) bool {
+	var previous, match, matchPrevious *NodeOf[T]
+	for node := l.Head; node != nil; previous, node = node, node.next {
+		if matcher(node) {
+			match, matchPrevious = node, previous
+		}
+	}
+	if match == nil {
+		return false
+	}
+	l.unlinkAfter(matchPrevious)
+	return true
+}
+
+// Find finds the first occurence of the value and returns the node
+func (l *LinkedListOf[T]) Find(matcher MatcherFnOf[T]) (bool, *NodeOf[T]) {
+	_, node := l.find(matcher)
+	return node != nil, node
+}
+
+// InsertBefore inserts a new node before the matched node
+func (l *LinkedListOf[T]) InsertBefore(matcher MatcherFnOf[T], new *NodeOf[T]) {
+	if previous, node := l.find(matcher); node != nil {
+		l.linkAfter(new, previous)
+	}
+}
+
+// InsertAfter inserts a new node after the matched node
+func (l *LinkedListOf[T]) InsertAfter(matcher MatcherFnOf[T], new *NodeOf[T]) {
+	if _, node := l.find(matcher); node != nil {
+		l.linkAfter(new, node)
+	}
+}
+
+// Clear removes all items from the list
+func (l *LinkedListOf[T]) Clear() {
+	l.Head = nil
+	l.Tail = nil
+	l.size = 0
+}
+
+// Get returns the node at the specified index
+func (l *LinkedListOf[T]) Get(index int) (bool, *NodeOf[T]) {
+	if index < 0 || index >= l.size {
+		return false, nil
+	}
+	node := l.Head
+	for i := 0; i < index; i++ {
+		node = node.next
+	}
+	return true, node
+}
+
+// Set replaces the node at the specified index
+func (l *LinkedListOf[T]) Set(index int, new *NodeOf[T]) bool {
+	if index < 0 || index >= l.size {
+		return false
+	}
+	var previous *NodeOf[T]
+	if index > 0 {
+		_, previous = l.Get(index - 1)
+	}
+	l.unlinkAfter(previous)
+	l.linkAfter(new, previous)
+	return true
+}
+
+// Size returns the total number of nodes in the list
+func (l *LinkedListOf[T]) Size() int {
+	return l.size
+}
+
+// ToSlice returns a slice of the nodes in this list
+func (l *LinkedListOf[T]) ToSlice() []*NodeOf[T] {
+	slice := make([]*NodeOf[T], 0, l.size)
+	for node := l.Head; node != nil; node = node.next {
+		slice = append(slice, node)
+	}
+	return slice
+}
+
+// IndexOf returns the first index of the node in the list
+func (l *LinkedListOf[T]) IndexOf(matcher MatcherFnOf[T]) int {
+	i := 0
+	for node := l.Head; node != nil; node = node.next {
+		if matcher(node) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// LastIndexOf returns the last index of the value in the list
+func (l *LinkedListOf[T]) LastIndexOf(matcher MatcherFnOf[T]) int {
+	last := -1
+	i := 0
+	for node := l.Head; node != nil; node = node.next {
+		if matcher(node) {
+			last = i
+		}
+		i++
+	}
+	return last
+}
+
+// Copy returns a copy of this linked list
+func (l *LinkedListOf[T]) Copy(copy func(node *NodeOf[T]) *NodeOf[T]) LinkedListOf[T] {
+	new := NewOf[T]()
+	for node := l.Head; node != nil; node = node.next {
+		new.Add(copy(node))
+	}
+	return new
+}
+
+// Iterator returns an iterator instance for iterating through the list
+func (l *LinkedListOf[T]) Iterator() IteratorOf[T] {
+	return IteratorOf[T]{next: l.Head}
+}
+
+// IteratorOf represents the iterator for a list holding values of type T
+type IteratorOf[T any] struct {
+	next *NodeOf[T]
+}
+
+// Iterator is the iterator for a LinkedList
+type Iterator = IteratorOf[interface{}]
+
+// HasNext indicates if another node can be returned by Next
+func (i *IteratorOf[T]) HasNext() bool {
+	return i.next != nil
+}
+
+// Next returns the next node in the list
+func (i *IteratorOf[T]) Next() *NodeOf[T] {
+	node := i.next
+	if node != nil {
+		i.next = node.next
+	}
+	return node
+}
+
+// All returns a sequence of the nodes in the list from Head to Tail.
+// The node being visited may be removed from the list during iteration.
+func (l *LinkedListOf[T]) All() iter.Seq[*NodeOf[T]] {
+	return func(yield func(*NodeOf[T]) bool) {
+		for node := l.Head; node != nil; {
+			next := node.next
+			if !yield(node) {
+				return
+			}
+			node = next
+		}
+	}
+}
+
+// Values returns a sequence of the values in the list from Head to Tail
+func (l *LinkedListOf[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.Head; node != nil; node = node.next {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
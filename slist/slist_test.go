@@ -0,0 +1,234 @@
+package slist_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/internal/listtest"
+	"github.com/miketmoore/data-structures-go/linkedlist"
+	"github.com/miketmoore/data-structures-go/slist"
+)
+
+func TestVectors(t *testing.T) {
+	for _, v := range listtest.Vectors {
+		t.Run(v.Name, func(t *testing.T) {
+			list := newList(v.Initial...)
+			result := apply(&list, v)
+			if result != v.Result {
+				t.Fatal("result is unexpected - got: ", result, " expected: ", v.Result)
+			}
+			assertListNodes(t, list, v.Expected...)
+		})
+	}
+}
+
+func apply(list *slist.LinkedListOf[int], v listtest.Vector) int {
+	equals := func(value int) slist.MatcherFnOf[int] {
+		return func(node *slist.NodeOf[int]) bool {
+			return node.Value == value
+		}
+	}
+	valueOf := func(node *slist.NodeOf[int]) int {
+		if node == nil {
+			return -1
+		}
+		return node.Value
+	}
+	boolResult := func(b bool) int {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	switch v.Op {
+	case listtest.Add:
+		list.Add(&slist.NodeOf[int]{Value: v.Value})
+	case listtest.AddToStart:
+		list.AddToStart(&slist.NodeOf[int]{Value: v.Value})
+	case listtest.RemoveHead:
+		return valueOf(list.RemoveHead())
+	case listtest.RemoveTail:
+		return valueOf(list.RemoveTail())
+	case listtest.RemoveFirstOccurrence:
+		return boolResult(list.RemoveFirstOccurrence(equals(v.Value)))
+	case listtest.RemoveLastOccurrence:
+		return boolResult(list.RemoveLastOccurrence(equals(v.Value)))
+	case listtest.InsertBefore:
+		list.InsertBefore(equals(v.Index), &slist.NodeOf[int]{Value: v.Value})
+	case listtest.InsertAfter:
+		list.InsertAfter(equals(v.Index), &slist.NodeOf[int]{Value: v.Value})
+	case listtest.Get:
+		_, node := list.Get(v.Index)
+		return valueOf(node)
+	case listtest.Set:
+		return boolResult(list.Set(v.Index, &slist.NodeOf[int]{Value: v.Value}))
+	case listtest.IndexOf:
+		return list.IndexOf(equals(v.Value))
+	case listtest.LastIndexOf:
+		return list.LastIndexOf(equals(v.Value))
+	}
+	return 0
+}
+
+func TestIterator(t *testing.T) {
+	list := newList(1, 2, 3)
+	it := list.Iterator()
+	collect := []int{}
+	for it.HasNext() {
+		collect = append(collect, it.Next().Value)
+	}
+	assertSlicesAreEqual(t, []int{1, 2, 3}, collect)
+	ok(t, it.Next() == nil)
+}
+
+func TestAll(t *testing.T) {
+	list := newList(1, 2, 3, 4)
+	for node := range list.All() {
+		if node.Value%2 == 0 {
+			list.RemoveFirstOccurrence(func(n *slist.NodeOf[int]) bool { return n == node })
+		}
+	}
+	assertListNodes(t, list, 1, 3)
+
+	collect := []int{}
+	for v := range list.Values() {
+		collect = append(collect, v)
+		break
+	}
+	assertSlicesAreEqual(t, []int{1}, collect)
+}
+
+func TestAddAllClearCopy(t *testing.T) {
+	list := newList()
+	list.AddAll([]*slist.NodeOf[int]{{Value: 1}, {Value: 2}})
+	assertListNodes(t, list, 1, 2)
+
+	copied := list.Copy(func(node *slist.NodeOf[int]) *slist.NodeOf[int] {
+		return &slist.NodeOf[int]{Value: node.Value * 10}
+	})
+	assertListNodes(t, copied, 10, 20)
+
+	list.Clear()
+	assertListNodes(t, list)
+	assertListNodes(t, copied, 10, 20)
+}
+
+func TestUntyped(t *testing.T) {
+	list := slist.New()
+	list.Add(&slist.Node{Value: "a"})
+	list.AddToStart(&slist.Node{Value: "b"})
+	found, node := list.Find(func(node *slist.Node) bool { return node.Value == "a" })
+	ok(t, found)
+	ok(t, node == list.Tail)
+	ok(t, list.Head.Next() == node)
+}
+
+func BenchmarkAddSingly(b *testing.B) {
+	b.ReportAllocs()
+	list := slist.NewOf[int]()
+	for i := 0; i < b.N; i++ {
+		list.Add(&slist.NodeOf[int]{Value: i})
+	}
+}
+
+func BenchmarkAddDoubly(b *testing.B) {
+	b.ReportAllocs()
+	list := linkedlist.NewOf[int]()
+	for i := 0; i < b.N; i++ {
+		list.Add(&linkedlist.NodeOf[int]{Value: i})
+	}
+}
+
+func BenchmarkPushPopSingly(b *testing.B) {
+	b.ReportAllocs()
+	list := slist.NewOf[int]()
+	for i := 0; i < b.N; i++ {
+		list.AddToStart(&slist.NodeOf[int]{Value: i})
+		list.RemoveHead()
+	}
+}
+
+func BenchmarkPushPopDoubly(b *testing.B) {
+	b.ReportAllocs()
+	list := linkedlist.NewOf[int]()
+	for i := 0; i < b.N; i++ {
+		list.AddToStart(&linkedlist.NodeOf[int]{Value: i})
+		list.RemoveHead()
+	}
+}
+
+const iterateSize = 100000
+
+func BenchmarkIterateSingly(b *testing.B) {
+	list := slist.NewOf[int]()
+	for i := 0; i < iterateSize; i++ {
+		list.Add(&slist.NodeOf[int]{Value: i})
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for v := range list.Values() {
+			sum += v
+		}
+	}
+}
+
+func BenchmarkIterateDoubly(b *testing.B) {
+	list := linkedlist.NewOf[int]()
+	for i := 0; i < iterateSize; i++ {
+		list.Add(&linkedlist.NodeOf[int]{Value: i})
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for v := range list.Values() {
+			sum += v
+		}
+	}
+}
+
+func newList(nums ...int) slist.LinkedListOf[int] {
+	list := slist.NewOf[int]()
+	for _, num := range nums {
+		list.Add(&slist.NodeOf[int]{Value: num})
+	}
+	return list
+}
+
+func assertListNodes(t *testing.T, list slist.LinkedListOf[int], expected ...int) {
+	if list.Size() != len(expected) {
+		t.Fatal("list size is unexpected - got: ", list.Size(), " expected: ", len(expected))
+	}
+	node := list.Head
+	for i := 0; i < len(expected); i++ {
+		if node.Value != expected[i] {
+			t.Fatal("node value is unexpected - index: ", i, " got: ", node.Value, " expected: ", expected[i])
+		}
+		if i == len(expected)-1 && node != list.Tail {
+			t.Fatal("tail is not the last node")
+		}
+		node = node.Next()
+	}
+	if node != nil {
+		t.Fatal("list has more nodes than expected")
+	}
+	if len(expected) == 0 && (list.Head != nil || list.Tail != nil) {
+		t.Fatal("empty list has a head or tail")
+	}
+}
+
+func ok(t *testing.T, b bool) {
+	if b == false {
+		t.Fatal("not ok")
+	}
+}
+
+func assertSlicesAreEqual(t *testing.T, expected, got []int) {
+	if len(expected) != len(got) {
+		t.Fatal("slices are not of equal length")
+	}
+	for i := 0; i < len(expected); i++ {
+		if expected[i] != got[i] {
+			t.Fatal("slice values are not equal")
+		}
+	}
+}
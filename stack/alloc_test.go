@@ -0,0 +1,28 @@
+//go:build !linkedlistdebug
+
+// Validating after every mutation allocates, so the allocation tests only
+// run in normal builds.
+
+package stack_test
+
+import (
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/stack"
+)
+
+func TestPushValueDoesNotAllocate(t *testing.T) {
+	s := stack.New()
+	var msg interface{} = "message"
+	for i := 0; i < 100; i++ {
+		s.PushValue(msg)
+	}
+	for i := 0; i < 100; i++ {
+		s.PopValue()
+	}
+	allocs := testing.AllocsPerRun(1000, func() {
+		s.PushValue(msg)
+		s.PopValue()
+	})
+	ok(t, allocs == 0)
+}
@@ -1,6 +1,10 @@
 package stack
 
-import "github.com/miketmoore/data-structures-go/linkedlist"
+import (
+	"iter"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+)
 
 // Stack is an adapter on top of LinkedList
 // It enforces the last-in first-out (LIFO) principle.
@@ -18,6 +22,24 @@ func (s *Stack) Pop() *linkedlist.Node {
 	return s.list.RemoveHead()
 }
 
+// PushValue adds a value to the top of the stack, reusing a node released
+// by PopValue when one is available
+func (s *Stack) PushValue(value interface{}) {
+	s.list.AddValueToStart(value)
+}
+
+// PopValue removes the top value from the stack. The node that held it is
+// kept for reuse, so it must not be retained.
+func (s *Stack) PopValue() (interface{}, bool) {
+	return s.list.RemoveHeadValue()
+}
+
+// ReleasePool drops the nodes kept for reuse by the value methods so they
+// can be collected
+func (s *Stack) ReleasePool() {
+	s.list.ReleasePool()
+}
+
 // IsEmpty indicates if the stack is empty or not
 func (s *Stack) IsEmpty() bool {
 	return s.list.Size() == 0
@@ -28,6 +50,24 @@ func (s *Stack) Peek() *linkedlist.Node {
 	return s.list.Head
 }
 
+// All returns a sequence of the nodes from the top of the stack to the
+// bottom without removing them
+func (s *Stack) All() iter.Seq[*linkedlist.Node] {
+	return s.list.All()
+}
+
+// Drain returns a sequence that pops each node as it is visited. Stopping
+// early leaves the remaining nodes on the stack.
+func (s *Stack) Drain() iter.Seq[*linkedlist.Node] {
+	return func(yield func(*linkedlist.Node) bool) {
+		for !s.IsEmpty() {
+			if !yield(s.Pop()) {
+				return
+			}
+		}
+	}
+}
+
 // New returns a new Stack instance
 func New() Stack {
 	return Stack{list: linkedlist.New()}
@@ -49,6 +49,75 @@ func TestIntegration(t *testing.T) {
 	ok(t, s.IsEmpty())
 }
 
+func TestAll(t *testing.T) {
+	s := stack.New()
+	s.Push(&linkedlist.Node{Value: 1})
+	s.Push(&linkedlist.Node{Value: 2})
+	s.Push(&linkedlist.Node{Value: 3})
+
+	expected := []int{3, 2, 1}
+	i := 0
+	for node := range s.All() {
+		ok(t, node.Value == expected[i])
+		i++
+	}
+	ok(t, i == 3)
+	ok(t, s.Peek().Value == 3)
+}
+
+func TestDrain(t *testing.T) {
+	s := stack.New()
+	s.Push(&linkedlist.Node{Value: 1})
+	s.Push(&linkedlist.Node{Value: 2})
+	s.Push(&linkedlist.Node{Value: 3})
+
+	for node := range s.Drain() {
+		if node.Value == 2 {
+			break
+		}
+	}
+	ok(t, s.Peek().Value == 1)
+
+	count := 0
+	for range s.Drain() {
+		count++
+	}
+	ok(t, count == 1)
+	ok(t, s.IsEmpty())
+}
+
+func TestPushValue(t *testing.T) {
+	s := stack.New()
+	s.PushValue("a")
+	s.PushValue("b")
+	v, found := s.PopValue()
+	ok(t, found && v == "b")
+	s.PushValue("c")
+	v, found = s.PopValue()
+	ok(t, found && v == "c")
+	v, found = s.PopValue()
+	ok(t, found && v == "a")
+	_, found = s.PopValue()
+	ok(t, !found)
+	ok(t, s.IsEmpty())
+}
+
+func TestPopValueKeepsCallerNodes(t *testing.T) {
+	s := stack.New()
+	node := &linkedlist.Node{Value: 1}
+	s.Push(node)
+	v, found := s.PopValue()
+	ok(t, found && v == 1)
+	s.PushValue(9)
+	ok(t, node.Value == 1)
+	ok(t, s.Pop() != node)
+
+	s.ReleasePool()
+	s.PushValue(2)
+	v, found = s.PopValue()
+	ok(t, found && v == 2)
+}
+
 func ok(t *testing.T, v bool) {
 	if v == false {
 		t.Fatal("not ok")
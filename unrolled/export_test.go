@@ -0,0 +1,11 @@
+package unrolled
+
+// BlockLengths returns the number of values held by each block, so tests
+// can check how full the blocks are kept
+func BlockLengths[T any](l *List[T]) []int {
+	lengths := []int{}
+	for b := l.head; b != nil; b = b.next {
+		lengths = append(lengths, len(b.values))
+	}
+	return lengths
+}
@@ -0,0 +1,345 @@
+// Package unrolled provides an unrolled linked list, where every node holds
+// a small array of values. Walking the list touches far fewer nodes than a
+// list with one value per node, which makes sequential access and index
+// lookups much friendlier to the CPU cache.
+package unrolled
+
+import (
+	"errors"
+	"iter"
+)
+
+// DefaultBlockSize is the number of values per block used when New is given
+// a block size less than one
+const DefaultBlockSize = 64
+
+// ErrIndexOutOfRange is returned when an index is outside the bounds of a list
+var ErrIndexOutOfRange = errors.New("unrolled: index out of range")
+
+// List is an unrolled linked list holding values of type T
+type List[T any] struct {
+	head      *block[T]
+	tail      *block[T]
+	size      int
+	blockSize int
+}
+
+// block is one node of the list, holding up to blockSize values
+type block[T any] struct {
+	values   []T
+	next     *block[T]
+	previous *block[T]
+}
+
+// New returns an empty list that stores up to blockSize values per node
+func New[T any](blockSize int) List[T] {
+	if blockSize < 1 {
+		blockSize = DefaultBlockSize
+	}
+	return List[T]{blockSize: blockSize}
+}
+
+// Size returns the total number of values in the list
+func (l *List[T]) Size() int {
+	return l.size
+}
+
+// BlockSize returns the maximum number of values stored per node
+func (l *List[T]) BlockSize() int {
+	if l.blockSize < 1 {
+		return DefaultBlockSize
+	}
+	return l.blockSize
+}
+
+func (l *List[T]) newBlock() *block[T] {
+	return &block[T]{values: make([]T, 0, l.BlockSize())}
+}
+
+// linkAfter links a new block after mark. A nil mark links it at the start.
+func (l *List[T]) linkAfter(b, mark *block[T]) {
+	var next *block[T]
+	if mark == nil {
+		next = l.head
+		l.head = b
+	} else {
+		next = mark.next
+		mark.next = b
+	}
+	if next == nil {
+		l.tail = b
+	} else {
+		next.previous = b
+	}
+	b.previous = mark
+	b.next = next
+}
+
+func (l *List[T]) unlink(b *block[T]) {
+	if b.previous == nil {
+		l.head = b.next
+	} else {
+		b.previous.next = b.next
+	}
+	if b.next == nil {
+		l.tail = b.previous
+	} else {
+		b.next.previous = b.previous
+	}
+	b.next = nil
+	b.previous = nil
+}
+
+// locate returns the block holding a valid index and the offset within it,
+// walking from whichever end is closer
+func (l *List[T]) locate(index int) (*block[T], int) {
+	if index < l.size/2 {
+		b := l.head
+		for index >= len(b.values) {
+			index -= len(b.values)
+			b = b.next
+		}
+		return b, index
+	}
+	b := l.tail
+	index = l.size - 1 - index
+	for index >= len(b.values) {
+		index -= len(b.values)
+		b = b.previous
+	}
+	return b, len(b.values) - 1 - index
+}
+
+// Add appends a value to the end of the list
+func (l *List[T]) Add(value T) {
+	if l.tail == nil || len(l.tail.values) == l.BlockSize() {
+		l.linkAfter(l.newBlock(), l.tail)
+	}
+	l.tail.values = append(l.tail.values, value)
+	l.size++
+}
+
+// AddToStart adds a value to the beginning of the list
+func (l *List[T]) AddToStart(value T) {
+	if l.head == nil || len(l.head.values) == l.BlockSize() {
+		l.linkAfter(l.newBlock(), nil)
+	}
+	l.head.values = insert(l.head.values, 0, value)
+	l.size++
+}
+
+// Get returns the value at the specified index
+func (l *List[T]) Get(index int) (bool, T) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return false, zero
+	}
+	b, offset := l.locate(index)
+	return true, b.values[offset]
+}
+
+// Set replaces the value at the specified index
+func (l *List[T]) Set(index int, value T) bool {
+	if index < 0 || index >= l.size {
+		return false
+	}
+	b, offset := l.locate(index)
+	b.values[offset] = value
+	return true
+}
+
+// InsertAt inserts a value at the specified index, shifting the value
+// currently at that index and any after it towards the end of the list.
+// An index equal to Size appends the value.
+func (l *List[T]) InsertAt(index int, value T) error {
+	if index < 0 || index > l.size {
+		return ErrIndexOutOfRange
+	}
+	if index == l.size {
+		l.Add(value)
+		return nil
+	}
+	b, offset := l.locate(index)
+	if len(b.values) == l.BlockSize() {
+		// split the full block in half and insert into whichever half the
+		// offset falls in
+		half := len(b.values) / 2
+		split := l.newBlock()
+		split.values = append(split.values, b.values[half:]...)
+		clear(b.values[half:])
+		b.values = b.values[:half]
+		l.linkAfter(split, b)
+		if offset > half {
+			b, offset = split, offset-half
+		}
+	}
+	b.values = insert(b.values, offset, value)
+	l.size++
+	return nil
+}
+
+// RemoveAt removes and returns the value at the specified index
+func (l *List[T]) RemoveAt(index int) (T, error) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+	b, offset := l.locate(index)
+	value := b.values[offset]
+	b.values = remove(b.values, offset)
+	l.size--
+
+	if len(b.values) == 0 {
+		l.unlink(b)
+	} else if len(b.values) < l.BlockSize()/2 {
+		l.rebalance(b)
+	}
+	return value, nil
+}
+
+// rebalance keeps blocks at least half full after b fell below half, by
+// merging b with a neighbour when both fit in one block, or otherwise by
+// borrowing a value from that neighbour, which has more than half to spare
+func (l *List[T]) rebalance(b *block[T]) {
+	if next := b.next; next != nil {
+		if len(b.values)+len(next.values) <= l.BlockSize() {
+			b.values = append(b.values, next.values...)
+			l.unlink(next)
+		} else {
+			b.values = append(b.values, next.values[0])
+			next.values = remove(next.values, 0)
+		}
+		return
+	}
+	if previous := b.previous; previous != nil {
+		if len(previous.values)+len(b.values) <= l.BlockSize() {
+			previous.values = append(previous.values, b.values...)
+			l.unlink(b)
+		} else {
+			last := len(previous.values) - 1
+			b.values = insert(b.values, 0, previous.values[last])
+			previous.values = remove(previous.values, last)
+		}
+	}
+}
+
+// RemoveHead removes and returns the first value in the list
+func (l *List[T]) RemoveHead() (T, bool) {
+	value, err := l.RemoveAt(0)
+	return value, err == nil
+}
+
+// RemoveTail removes and returns the last value in the list
+func (l *List[T]) RemoveTail() (T, bool) {
+	value, err := l.RemoveAt(l.size - 1)
+	return value, err == nil
+}
+
+// Clear removes all values from the list
+func (l *List[T]) Clear() {
+	l.head = nil
+	l.tail = nil
+	l.size = 0
+}
+
+// ToSlice returns a slice of the values in this list
+func (l *List[T]) ToSlice() []T {
+	slice := make([]T, 0, l.size)
+	for b := l.head; b != nil; b = b.next {
+		slice = append(slice, b.values...)
+	}
+	return slice
+}
+
+// Iterator returns an iterator instance for iterating through the list
+func (l *List[T]) Iterator() Iterator[T] {
+	return Iterator[T]{block: l.head}
+}
+
+// Iterator iterates forward over the values of a List
+type Iterator[T any] struct {
+	block  *block[T]
+	offset int
+}
+
+// HasNext indicates if another value can be returned by Next
+func (i *Iterator[T]) HasNext() bool {
+	return i.block != nil && i.offset < len(i.block.values)
+}
+
+// Next returns the next value in the list
+func (i *Iterator[T]) Next() T {
+	if !i.HasNext() {
+		var zero T
+		return zero
+	}
+	value := i.block.values[i.offset]
+	i.offset++
+	if i.offset == len(i.block.values) {
+		i.block = i.block.next
+		i.offset = 0
+	}
+	return value
+}
+
+// All returns a sequence of index and value pairs from the start of the
+// list to the end
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for b := l.head; b != nil; b = b.next {
+			for _, value := range b.values {
+				if !yield(i, value) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Values returns a sequence of the values from the start of the list to
+// the end
+func (l *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for b := l.head; b != nil; b = b.next {
+			for _, value := range b.values {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Backward returns a sequence of index and value pairs from the end of the
+// list to the start
+func (l *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := l.size - 1
+		for b := l.tail; b != nil; b = b.previous {
+			for j := len(b.values) - 1; j >= 0; j-- {
+				if !yield(i, b.values[j]) {
+					return
+				}
+				i--
+			}
+		}
+	}
+}
+
+// insert places value at offset, shifting later values along. The slice
+// must have spare capacity.
+func insert[T any](values []T, offset int, value T) []T {
+	values = values[:len(values)+1]
+	copy(values[offset+1:], values[offset:])
+	values[offset] = value
+	return values
+}
+
+func remove[T any](values []T, offset int) []T {
+	copy(values[offset:], values[offset+1:])
+	var zero T
+	values[len(values)-1] = zero
+	return values[:len(values)-1]
+}
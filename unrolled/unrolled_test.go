@@ -0,0 +1,279 @@
+package unrolled_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/miketmoore/data-structures-go/linkedlist"
+	"github.com/miketmoore/data-structures-go/unrolled"
+)
+
+func TestNew(t *testing.T) {
+	list := unrolled.New[int](0)
+	ok(t, list.BlockSize() == unrolled.DefaultBlockSize)
+	list = unrolled.New[int](8)
+	ok(t, list.BlockSize() == 8)
+
+	var zero unrolled.List[int]
+	zero.Add(1)
+	assertListValues(t, zero, 1)
+}
+
+func TestAdd(t *testing.T) {
+	list := unrolled.New[int](2)
+	for i := 3; i <= 5; i++ {
+		list.Add(i)
+	}
+	for i := 2; i >= 1; i-- {
+		list.AddToStart(i)
+	}
+	assertListValues(t, list, 1, 2, 3, 4, 5)
+}
+
+func TestGetSet(t *testing.T) {
+	list := newList(3, 1, 2, 3, 4, 5, 6, 7)
+	for i := 0; i < 7; i++ {
+		found, v := list.Get(i)
+		ok(t, found)
+		ok(t, v == i+1)
+	}
+	found, _ := list.Get(7)
+	ok(t, !found)
+	found, _ = list.Get(-1)
+	ok(t, !found)
+
+	ok(t, list.Set(4, 50))
+	ok(t, !list.Set(7, 70))
+	assertListValues(t, list, 1, 2, 3, 4, 50, 6, 7)
+}
+
+func TestInsertAt(t *testing.T) {
+	list := newList(4, 1, 2, 3, 4)
+	ok(t, list.InsertAt(0, 0) == nil)
+	ok(t, list.InsertAt(3, 25) == nil)
+	ok(t, list.InsertAt(6, 5) == nil)
+	assertListValues(t, list, 0, 1, 2, 25, 3, 4, 5)
+	ok(t, list.InsertAt(8, 9) == unrolled.ErrIndexOutOfRange)
+	ok(t, list.InsertAt(-1, 9) == unrolled.ErrIndexOutOfRange)
+}
+
+func TestRemoveAt(t *testing.T) {
+	list := newList(2, 1, 2, 3, 4, 5)
+	v, err := list.RemoveAt(2)
+	ok(t, err == nil && v == 3)
+	v, err = list.RemoveAt(3)
+	ok(t, err == nil && v == 5)
+	assertListValues(t, list, 1, 2, 4)
+	_, err = list.RemoveAt(3)
+	ok(t, err == unrolled.ErrIndexOutOfRange)
+
+	v, found := list.RemoveHead()
+	ok(t, found && v == 1)
+	v, found = list.RemoveTail()
+	ok(t, found && v == 4)
+	v, found = list.RemoveTail()
+	ok(t, found && v == 2)
+	_, found = list.RemoveHead()
+	ok(t, !found)
+	assertListValues(t, list)
+}
+
+func TestRemoveAtKeepsBlocksHalfFull(t *testing.T) {
+	values := make([]int, 24)
+	for i := range values {
+		values[i] = i
+	}
+	list := newList(8, values...)
+	assertSlicesAreEqual(t, []int{8, 8, 8}, unrolled.BlockLengths(&list))
+	for i := 0; i < 5; i++ {
+		list.RemoveAt(0)
+	}
+	// the first block borrowed from its full neighbour
+	assertSlicesAreEqual(t, []int{4, 7, 8}, unrolled.BlockLengths(&list))
+	for i := 0; i < 4; i++ {
+		list.RemoveAt(0)
+	}
+	// then merged with it once both fit in one block
+	assertSlicesAreEqual(t, []int{7, 8}, unrolled.BlockLengths(&list))
+
+	for i := 14; i >= 10; i-- {
+		list.RemoveAt(i)
+	}
+	// the last block borrowed from the block before it
+	assertSlicesAreEqual(t, []int{6, 4}, unrolled.BlockLengths(&list))
+	assertListValues(t, list, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18)
+}
+
+// TestAgainstSlice applies random operations to a list and a slice and checks
+// that they always agree
+func TestAgainstSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, blockSize := range []int{1, 2, 3, 8} {
+		list := unrolled.New[int](blockSize)
+		model := []int{}
+		for i := 0; i < 2000; i++ {
+			switch op := r.Intn(5); {
+			case op == 0:
+				list.Add(i)
+				model = append(model, i)
+			case op == 1:
+				list.AddToStart(i)
+				model = slices.Insert(model, 0, i)
+			case op == 2:
+				index := r.Intn(len(model) + 1)
+				ok(t, list.InsertAt(index, i) == nil)
+				model = slices.Insert(model, index, i)
+			case len(model) > 0:
+				index := r.Intn(len(model))
+				v, err := list.RemoveAt(index)
+				ok(t, err == nil && v == model[index])
+				model = slices.Delete(model, index, index+1)
+			}
+			if i%100 == 0 {
+				assertListValues(t, list, model...)
+				assertBlocksHalfFull(t, list)
+			}
+		}
+		assertListValues(t, list, model...)
+	}
+}
+
+func TestIterators(t *testing.T) {
+	list := newList(2, 1, 2, 3, 4, 5)
+
+	it := list.Iterator()
+	collect := []int{}
+	for it.HasNext() {
+		collect = append(collect, it.Next())
+	}
+	assertSlicesAreEqual(t, []int{1, 2, 3, 4, 5}, collect)
+
+	indexes := []int{}
+	collect = []int{}
+	for i, v := range list.Backward() {
+		indexes = append(indexes, i)
+		collect = append(collect, v)
+	}
+	assertSlicesAreEqual(t, []int{4, 3, 2, 1, 0}, indexes)
+	assertSlicesAreEqual(t, []int{5, 4, 3, 2, 1}, collect)
+
+	collect = []int{}
+	for v := range list.Values() {
+		if v == 3 {
+			break
+		}
+		collect = append(collect, v)
+	}
+	assertSlicesAreEqual(t, []int{1, 2}, collect)
+
+	list.Clear()
+	assertListValues(t, list)
+}
+
+const benchSize = 100000
+
+func BenchmarkIterateUnrolled(b *testing.B) {
+	list := unrolled.New[int](0)
+	for i := 0; i < benchSize; i++ {
+		list.Add(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for v := range list.Values() {
+			sum += v
+		}
+	}
+}
+
+func BenchmarkIterateLinkedList(b *testing.B) {
+	list := linkedlist.NewOf[int]()
+	for i := 0; i < benchSize; i++ {
+		list.Add(&linkedlist.NodeOf[int]{Value: i})
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for v := range list.Values() {
+			sum += v
+		}
+	}
+}
+
+func BenchmarkGetUnrolled(b *testing.B) {
+	list := unrolled.New[int](0)
+	for i := 0; i < benchSize; i++ {
+		list.Add(i)
+	}
+	r := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Get(r.Intn(benchSize))
+	}
+}
+
+func BenchmarkGetLinkedList(b *testing.B) {
+	list := linkedlist.NewOf[int]()
+	for i := 0; i < benchSize; i++ {
+		list.Add(&linkedlist.NodeOf[int]{Value: i})
+	}
+	r := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Get(r.Intn(benchSize))
+	}
+}
+
+func newList(blockSize int, values ...int) unrolled.List[int] {
+	list := unrolled.New[int](blockSize)
+	for _, v := range values {
+		list.Add(v)
+	}
+	return list
+}
+
+func assertListValues(t *testing.T, list unrolled.List[int], expected ...int) {
+	if list.Size() != len(expected) {
+		t.Fatal("list size is unexpected - got: ", list.Size(), " expected: ", len(expected))
+	}
+	assertSlicesAreEqual(t, expected, list.ToSlice())
+	for i, v := range list.All() {
+		if v != expected[i] {
+			t.Fatal("value is unexpected - index: ", i, " got: ", v, " expected: ", expected[i])
+		}
+	}
+	for i := range expected {
+		if _, v := list.Get(i); v != expected[i] {
+			t.Fatal("Get is unexpected - index: ", i, " got: ", v, " expected: ", expected[i])
+		}
+	}
+}
+
+// assertBlocksHalfFull checks that every block between the first and the
+// last holds at least half a block of values
+func assertBlocksHalfFull(t *testing.T, list unrolled.List[int]) {
+	lengths := unrolled.BlockLengths(&list)
+	for i := 1; i < len(lengths)-1; i++ {
+		if lengths[i] < list.BlockSize()/2 {
+			t.Fatal("block is less than half full - lengths: ", lengths)
+		}
+	}
+}
+
+func ok(t *testing.T, b bool) {
+	if b == false {
+		t.Fatal("not ok")
+	}
+}
+
+func assertSlicesAreEqual(t *testing.T, expected, got []int) {
+	if len(expected) != len(got) {
+		t.Fatal("slices are not of equal length")
+	}
+	for i := 0; i < len(expected); i++ {
+		if expected[i] != got[i] {
+			t.Fatal("slice values are not equal")
+		}
+	}
+}